@@ -25,7 +25,16 @@ type SubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
 	StartBlock uint64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// filters, empty matches all. an event matches an address when it is the ierc from or to.
+	Ticks     []string  `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	Addresses []string  `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Operates  []Operate `protobuf:"varint,4,rep,packed,name=operates,proto3,enum=api.indexer.Operate" json:"operates,omitempty"`
+	// failed events (err_code != 0) are included unless it is set to false.
+	IncludeFailed *bool `protobuf:"varint,5,opt,name=include_failed,json=includeFailed,proto3,oneof" json:"include_failed,omitempty"`
+	// cursor of the last reply received, resumes right after it. start_block is ignored when set.
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *SubscribeRequest) Reset() {
@@ -67,13 +76,53 @@ func (x *SubscribeRequest) GetStartBlock() uint64 {
 	return 0
 }
 
+func (x *SubscribeRequest) GetTicks() []string {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetOperates() []Operate {
+	if x != nil {
+		return x.Operates
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetIncludeFailed() bool {
+	if x != nil && x.IncludeFailed != nil {
+		return *x.IncludeFailed
+	}
+	return false
+}
+
+func (x *SubscribeRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type SubscribeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	PrevBlockNumber uint64 `protobuf:"varint,2,opt,name=prev_block_number,json=prevBlockNumber,proto3" json:"prev_block_number,omitempty"`
-	Events []*Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+
+	BlockNumber     uint64   `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	PrevBlockNumber uint64   `protobuf:"varint,2,opt,name=prev_block_number,json=prevBlockNumber,proto3" json:"prev_block_number,omitempty"`
+	Events          []*Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// chain reorganization. events above block_number have been revoked
+	Rollback bool `protobuf:"varint,4,opt,name=rollback,proto3" json:"rollback,omitempty"`
+	// position of the reply, increasing except on rollback.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *SubscribeReply) Reset() {
@@ -129,6 +178,20 @@ func (x *SubscribeReply) GetEvents() []*Event {
 	return nil
 }
 
+func (x *SubscribeReply) GetRollback() bool {
+	if x != nil {
+		return x.Rollback
+	}
+	return false
+}
+
+func (x *SubscribeReply) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type SubscribeSystemStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -172,9 +235,9 @@ type SubscribeSystemStatusReply struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LatestBlock uint64 `protobuf:"varint,1,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
+	LatestBlock  uint64 `protobuf:"varint,1,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
 	IndexedBlock uint64 `protobuf:"varint,2,opt,name=indexed_block,json=indexedBlock,proto3" json:"indexed_block,omitempty"`
-	SyncBlock uint64 `protobuf:"varint,3,opt,name=sync_block,json=syncBlock,proto3" json:"sync_block,omitempty"`
+	SyncBlock    uint64 `protobuf:"varint,3,opt,name=sync_block,json=syncBlock,proto3" json:"sync_block,omitempty"`
 }
 
 func (x *SubscribeSystemStatusReply) Reset() {
@@ -234,8 +297,24 @@ type QueryEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
 	StartBlock uint64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
-	Size       int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// number of blocks with events after start_block, each block with all its events.
+	// ignored with any filter, cursor or page_size set, the events are paged instead
+	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Tick string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	// ierc sender or receiver
+	Address string  `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Operate Operate `protobuf:"varint,5,opt,name=operate,proto3,enum=api.indexer.Operate" json:"operate,omitempty"`
+	// 0 for successful events only
+	ErrCode *int32 `protobuf:"varint,6,opt,name=err_code,json=errCode,proto3,oneof" json:"err_code,omitempty"`
+	// event_at range in milliseconds, [event_at_from, event_at_to)
+	EventAtFrom int64 `protobuf:"varint,7,opt,name=event_at_from,json=eventAtFrom,proto3" json:"event_at_from,omitempty"`
+	EventAtTo   int64 `protobuf:"varint,8,opt,name=event_at_to,json=eventAtTo,proto3" json:"event_at_to,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// number of events of a page, the events of the last block may continue on the next page. default: 20, max: 100
+	PageSize int64 `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
 func (x *QueryEventsRequest) Reset() {
@@ -284,12 +363,70 @@ func (x *QueryEventsRequest) GetSize() int64 {
 	return 0
 }
 
+func (x *QueryEventsRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *QueryEventsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryEventsRequest) GetOperate() Operate {
+	if x != nil {
+		return x.Operate
+	}
+	return Operate_OPERATE_UNSPECIFIED
+}
+
+func (x *QueryEventsRequest) GetErrCode() int32 {
+	if x != nil && x.ErrCode != nil {
+		return *x.ErrCode
+	}
+	return 0
+}
+
+func (x *QueryEventsRequest) GetEventAtFrom() int64 {
+	if x != nil {
+		return x.EventAtFrom
+	}
+	return 0
+}
+
+func (x *QueryEventsRequest) GetEventAtTo() int64 {
+	if x != nil {
+		return x.EventAtTo
+	}
+	return 0
+}
+
+func (x *QueryEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *QueryEventsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type QueryEventsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	EventByBlocks []*QueryEventsReply_EventsByBlock `protobuf:"bytes,1,rep,name=event_by_blocks,json=eventByBlocks,proto3" json:"event_by_blocks,omitempty"`
+	// set on a filtered query, empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
 func (x *QueryEventsReply) Reset() {
@@ -331,6 +468,13 @@ func (x *QueryEventsReply) GetEventByBlocks() []*QueryEventsReply_EventsByBlock
 	return nil
 }
 
+func (x *QueryEventsReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type QuerySystemStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -373,6 +517,7 @@ type QuerySystemStatusReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
 	SyncBlock uint64 `protobuf:"varint,1,opt,name=sync_block,json=syncBlock,proto3" json:"sync_block,omitempty"`
 }
 
@@ -517,17 +662,23 @@ func (x *CheckTransferReply) GetData() *CheckTransferReply_TransferRecord {
 	return nil
 }
 
-type QueryEventsReply_EventsByBlock struct {
+type Balance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	PrevBlockNumber uint64 `protobuf:"varint,2,opt,name=prev_block_number,json=prevBlockNumber,proto3" json:"prev_block_number,omitempty"`
-	Events []*Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tick      string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	Available string `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
+	Freeze    string `protobuf:"bytes,4,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	Minted    string `protobuf:"bytes,5,opt,name=minted,proto3" json:"minted,omitempty"`
+	// available + freeze
+	Total            string `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	LastUpdatedBlock uint64 `protobuf:"varint,7,opt,name=last_updated_block,json=lastUpdatedBlock,proto3" json:"last_updated_block,omitempty"`
 }
 
-func (x *QueryEventsReply_EventsByBlock) Reset() {
-	*x = QueryEventsReply_EventsByBlock{}
+func (x *Balance) Reset() {
+	*x = Balance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -535,13 +686,13 @@ func (x *QueryEventsReply_EventsByBlock) Reset() {
 	}
 }
 
-func (x *QueryEventsReply_EventsByBlock) String() string {
+func (x *Balance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryEventsReply_EventsByBlock) ProtoMessage() {}
+func (*Balance) ProtoMessage() {}
 
-func (x *QueryEventsReply_EventsByBlock) ProtoReflect() protoreflect.Message {
+func (x *Balance) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,46 +704,71 @@ func (x *QueryEventsReply_EventsByBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryEventsReply_EventsByBlock.ProtoReflect.Descriptor instead.
-func (*QueryEventsReply_EventsByBlock) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryEventsReply_EventsByBlock) GetBlockNumber() uint64 {
+func (x *Balance) GetAddress() string {
 	if x != nil {
-		return x.BlockNumber
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryEventsReply_EventsByBlock) GetPrevBlockNumber() uint64 {
+func (x *Balance) GetTick() string {
 	if x != nil {
-		return x.PrevBlockNumber
+		return x.Tick
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryEventsReply_EventsByBlock) GetEvents() []*Event {
+func (x *Balance) GetAvailable() string {
 	if x != nil {
-		return x.Events
+		return x.Available
 	}
-	return nil
+	return ""
 }
 
-type CheckTransferReply_TransferRecord struct {
+func (x *Balance) GetFreeze() string {
+	if x != nil {
+		return x.Freeze
+	}
+	return ""
+}
+
+func (x *Balance) GetMinted() string {
+	if x != nil {
+		return x.Minted
+	}
+	return ""
+}
+
+func (x *Balance) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *Balance) GetLastUpdatedBlock() uint64 {
+	if x != nil {
+		return x.LastUpdatedBlock
+	}
+	return 0
+}
+
+type GetBalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	Tick     string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
-	Amount   string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status   bool   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tick    string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
 }
 
-func (x *CheckTransferReply_TransferRecord) Reset() {
-	*x = CheckTransferReply_TransferRecord{}
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,13 +776,13 @@ func (x *CheckTransferReply_TransferRecord) Reset() {
 	}
 }
 
-func (x *CheckTransferReply_TransferRecord) String() string {
+func (x *GetBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckTransferReply_TransferRecord) ProtoMessage() {}
+func (*GetBalanceRequest) ProtoMessage() {}
 
-func (x *CheckTransferReply_TransferRecord) ProtoReflect() protoreflect.Message {
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -618,223 +794,6680 @@ func (x *CheckTransferReply_TransferRecord) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckTransferReply_TransferRecord.ProtoReflect.Descriptor instead.
-func (*CheckTransferReply_TransferRecord) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CheckTransferReply_TransferRecord) GetSender() string {
+func (x *GetBalanceRequest) GetAddress() string {
 	if x != nil {
-		return x.Sender
+		return x.Address
 	}
 	return ""
 }
 
-func (x *CheckTransferReply_TransferRecord) GetReceiver() string {
+func (x *GetBalanceRequest) GetTick() string {
 	if x != nil {
-		return x.Receiver
+		return x.Tick
 	}
 	return ""
 }
 
-func (x *CheckTransferReply_TransferRecord) GetTick() string {
+type GetBalanceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance *Balance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *GetBalanceReply) Reset() {
+	*x = GetBalanceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceReply) ProtoMessage() {}
+
+func (x *GetBalanceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceReply.ProtoReflect.Descriptor instead.
+func (*GetBalanceReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetBalanceReply) GetBalance() *Balance {
 	if x != nil {
-		return x.Tick
+		return x.Balance
+	}
+	return nil
+}
+
+type ListBalancesByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// default: 20, max: 100
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// sort by total descending instead of tick
+	OrderByTotal bool `protobuf:"varint,4,opt,name=order_by_total,json=orderByTotal,proto3" json:"order_by_total,omitempty"`
+}
+
+func (x *ListBalancesByAddressRequest) Reset() {
+	*x = ListBalancesByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalancesByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalancesByAddressRequest) ProtoMessage() {}
+
+func (x *ListBalancesByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalancesByAddressRequest.ProtoReflect.Descriptor instead.
+func (*ListBalancesByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListBalancesByAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
 	return ""
 }
 
-func (x *CheckTransferReply_TransferRecord) GetAmount() string {
+func (x *ListBalancesByAddressRequest) GetCursor() string {
 	if x != nil {
-		return x.Amount
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *CheckTransferReply_TransferRecord) GetStatus() bool {
+func (x *ListBalancesByAddressRequest) GetSize() int64 {
 	if x != nil {
-		return x.Status
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListBalancesByAddressRequest) GetOrderByTotal() bool {
+	if x != nil {
+		return x.OrderByTotal
 	}
 	return false
 }
 
-var File_indexer_indexer_proto protoreflect.FileDescriptor
+type ListBalancesByAddressReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_indexer_indexer_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x8b, 0x01, 0x0a,
-	0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70,
-	0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a,
-	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x22, 0x49, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x10,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x53, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42,
-	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72,
-	0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79,
-	0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x51, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x42, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x88, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x32, 0xaf, 0x04, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x15,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x0b, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x7d, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x79, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x42, 0x44, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x49, 0x45, 0x72, 0x63, 0x4f, 0x72, 0x67, 0x2f, 0x49, 0x45, 0x52, 0x43, 0x5f, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Balances []*Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-var (
-	file_indexer_indexer_proto_rawDescOnce sync.Once
-	file_indexer_indexer_proto_rawDescData = file_indexer_indexer_proto_rawDesc
-)
-
-func file_indexer_indexer_proto_rawDescGZIP() []byte {
-	file_indexer_indexer_proto_rawDescOnce.Do(func() {
-		file_indexer_indexer_proto_rawDescData = protoimpl.X.CompressGZIP(file_indexer_indexer_proto_rawDescData)
-	})
-	return file_indexer_indexer_proto_rawDescData
+func (x *ListBalancesByAddressReply) Reset() {
+	*x = ListBalancesByAddressReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_indexer_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_indexer_indexer_proto_goTypes = []interface{}{
-	(*SubscribeRequest)(nil),                  // 0: api.indexer.SubscribeRequest
-	(*SubscribeReply)(nil),                    // 1: api.indexer.SubscribeReply
-	(*SubscribeSystemStatusRequest)(nil),      // 2: api.indexer.SubscribeSystemStatusRequest
-	(*SubscribeSystemStatusReply)(nil),        // 3: api.indexer.SubscribeSystemStatusReply
-	(*QueryEventsRequest)(nil),                // 4: api.indexer.QueryEventsRequest
-	(*QueryEventsReply)(nil),                  // 5: api.indexer.QueryEventsReply
-	(*QuerySystemStatusRequest)(nil),          // 6: api.indexer.QuerySystemStatusRequest
-	(*QuerySystemStatusReply)(nil),            // 7: api.indexer.QuerySystemStatusReply
-	(*CheckTransferRequest)(nil),              // 8: api.indexer.CheckTransferRequest
-	(*CheckTransferReply)(nil),                // 9: api.indexer.CheckTransferReply
-	(*QueryEventsReply_EventsByBlock)(nil),    // 10: api.indexer.QueryEventsReply.EventsByBlock
-	(*CheckTransferReply_TransferRecord)(nil), // 11: api.indexer.CheckTransferReply.TransferRecord
-	(*Event)(nil),                             // 12: api.indexer.Event
+func (x *ListBalancesByAddressReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_indexer_indexer_proto_depIdxs = []int32{
-	12, // 0: api.indexer.SubscribeReply.events:type_name -> api.indexer.Event
-	10, // 1: api.indexer.QueryEventsReply.event_by_blocks:type_name -> api.indexer.QueryEventsReply.EventsByBlock
-	11, // 2: api.indexer.CheckTransferReply.data:type_name -> api.indexer.CheckTransferReply.TransferRecord
-	12, // 3: api.indexer.QueryEventsReply.EventsByBlock.events:type_name -> api.indexer.Event
-	0,  // 4: api.indexer.Indexer.SubscribeEvent:input_type -> api.indexer.SubscribeRequest
-	2,  // 5: api.indexer.Indexer.SubscribeSystemStatus:input_type -> api.indexer.SubscribeSystemStatusRequest
-	4,  // 6: api.indexer.Indexer.QueryEvents:input_type -> api.indexer.QueryEventsRequest
-	6,  // 7: api.indexer.Indexer.QuerySystemStatus:input_type -> api.indexer.QuerySystemStatusRequest
-	8,  // 8: api.indexer.Indexer.CheckTransfer:input_type -> api.indexer.CheckTransferRequest
-	1,  // 9: api.indexer.Indexer.SubscribeEvent:output_type -> api.indexer.SubscribeReply
-	3,  // 10: api.indexer.Indexer.SubscribeSystemStatus:output_type -> api.indexer.SubscribeSystemStatusReply
-	5,  // 11: api.indexer.Indexer.QueryEvents:output_type -> api.indexer.QueryEventsReply
-	7,  // 12: api.indexer.Indexer.QuerySystemStatus:output_type -> api.indexer.QuerySystemStatusReply
-	9,  // 13: api.indexer.Indexer.CheckTransfer:output_type -> api.indexer.CheckTransferReply
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+func (*ListBalancesByAddressReply) ProtoMessage() {}
+
+func (x *ListBalancesByAddressReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_indexer_indexer_proto_init() }
-func file_indexer_indexer_proto_init() {
-	if File_indexer_indexer_proto != nil {
-		return
+// Deprecated: Use ListBalancesByAddressReply.ProtoReflect.Descriptor instead.
+func (*ListBalancesByAddressReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListBalancesByAddressReply) GetBalances() []*Balance {
+	if x != nil {
+		return x.Balances
 	}
-	file_indexer_event_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_indexer_indexer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+	return nil
+}
+
+func (x *ListBalancesByAddressReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListHoldersByTickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// default: 20, max: 100
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListHoldersByTickRequest) Reset() {
+	*x = ListHoldersByTickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHoldersByTickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldersByTickRequest) ProtoMessage() {}
+
+func (x *ListHoldersByTickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldersByTickRequest.ProtoReflect.Descriptor instead.
+func (*ListHoldersByTickRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListHoldersByTickRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *ListHoldersByTickRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListHoldersByTickRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListHoldersByTickReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sorted by total descending
+	Holders []*Balance `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListHoldersByTickReply) Reset() {
+	*x = ListHoldersByTickReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHoldersByTickReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldersByTickReply) ProtoMessage() {}
+
+func (x *ListHoldersByTickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldersByTickReply.ProtoReflect.Descriptor instead.
+func (*ListHoldersByTickReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListHoldersByTickReply) GetHolders() []*Balance {
+	if x != nil {
+		return x.Holders
+	}
+	return nil
+}
+
+func (x *ListHoldersByTickReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetBalanceAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tick    string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	// the balance at the end of the block
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *GetBalanceAtRequest) Reset() {
+	*x = GetBalanceAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtRequest) ProtoMessage() {}
+
+func (x *GetBalanceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBalanceAtRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetBalanceAtRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *GetBalanceAtRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type GetBalanceAtReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// last_updated_block is the last block changing the balance up to block_number
+	Balance *Balance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *GetBalanceAtReply) Reset() {
+	*x = GetBalanceAtReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceAtReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtReply) ProtoMessage() {}
+
+func (x *GetBalanceAtReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtReply.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBalanceAtReply) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type BalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tick        string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// the balance at the end of the block
+	Available string `protobuf:"bytes,4,opt,name=available,proto3" json:"available,omitempty"`
+	Freeze    string `protobuf:"bytes,5,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	Minted    string `protobuf:"bytes,6,opt,name=minted,proto3" json:"minted,omitempty"`
+	// the amounts changed by the block
+	AvailableDelta string `protobuf:"bytes,7,opt,name=available_delta,json=availableDelta,proto3" json:"available_delta,omitempty"`
+	FreezeDelta    string `protobuf:"bytes,8,opt,name=freeze_delta,json=freezeDelta,proto3" json:"freeze_delta,omitempty"`
+	MintedDelta    string `protobuf:"bytes,9,opt,name=minted_delta,json=mintedDelta,proto3" json:"minted_delta,omitempty"`
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BalanceChange) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetAvailable() string {
+	if x != nil {
+		return x.Available
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetFreeze() string {
+	if x != nil {
+		return x.Freeze
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetMinted() string {
+	if x != nil {
+		return x.Minted
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetAvailableDelta() string {
+	if x != nil {
+		return x.AvailableDelta
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetFreezeDelta() string {
+	if x != nil {
+		return x.FreezeDelta
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetMintedDelta() string {
+	if x != nil {
+		return x.MintedDelta
+	}
+	return ""
+}
+
+type ListBalanceChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// all ticks when empty
+	Tick string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// default: 20, max: 100
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListBalanceChangesRequest) Reset() {
+	*x = ListBalanceChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalanceChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceChangesRequest) ProtoMessage() {}
+
+func (x *ListBalanceChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListBalanceChangesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListBalanceChangesRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ListBalanceChangesRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *ListBalanceChangesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBalanceChangesRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListBalanceChangesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// latest first
+	Changes []*BalanceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListBalanceChangesReply) Reset() {
+	*x = ListBalanceChangesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalanceChangesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceChangesReply) ProtoMessage() {}
+
+func (x *ListBalanceChangesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceChangesReply.ProtoReflect.Descriptor instead.
+func (*ListBalanceChangesReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListBalanceChangesReply) GetChanges() []*BalanceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListBalanceChangesReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type Tick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol  string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Tick      string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	Decimals  int64  `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	MaxSupply string `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// current supply
+	Supply           string `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply,omitempty"`
+	Creator          string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	LastUpdatedBlock uint64 `protobuf:"varint,7,opt,name=last_updated_block,json=lastUpdatedBlock,proto3" json:"last_updated_block,omitempty"`
+	// Types that are assignable to Detail:
+	//	*Tick_Ierc20
+	//	*Tick_IercPow
+	Detail isTick_Detail `protobuf_oneof:"detail"`
+}
+
+func (x *Tick) Reset() {
+	*x = Tick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tick) ProtoMessage() {}
+
+func (x *Tick) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tick.ProtoReflect.Descriptor instead.
+func (*Tick) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Tick) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Tick) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *Tick) GetDecimals() int64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Tick) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *Tick) GetSupply() string {
+	if x != nil {
+		return x.Supply
+	}
+	return ""
+}
+
+func (x *Tick) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Tick) GetLastUpdatedBlock() uint64 {
+	if x != nil {
+		return x.LastUpdatedBlock
+	}
+	return 0
+}
+
+func (m *Tick) GetDetail() isTick_Detail {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+func (x *Tick) GetIerc20() *Tick_IERC20Detail {
+	if x, ok := x.GetDetail().(*Tick_Ierc20); ok {
+		return x.Ierc20
+	}
+	return nil
+}
+
+func (x *Tick) GetIercPow() *Tick_IERCPoWDetail {
+	if x, ok := x.GetDetail().(*Tick_IercPow); ok {
+		return x.IercPow
+	}
+	return nil
+}
+
+type isTick_Detail interface {
+	isTick_Detail()
+}
+
+type Tick_Ierc20 struct {
+	Ierc20 *Tick_IERC20Detail `protobuf:"bytes,8,opt,name=ierc20,proto3,oneof"`
+}
+
+type Tick_IercPow struct {
+	IercPow *Tick_IERCPoWDetail `protobuf:"bytes,9,opt,name=ierc_pow,json=iercPow,proto3,oneof"`
+}
+
+func (*Tick_Ierc20) isTick_Detail() {}
+
+func (*Tick_IercPow) isTick_Detail() {}
+
+type GetTickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *GetTickRequest) Reset() {
+	*x = GetTickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickRequest) ProtoMessage() {}
+
+func (x *GetTickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickRequest.ProtoReflect.Descriptor instead.
+func (*GetTickRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTickRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+type GetTickReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick *Tick `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *GetTickReply) Reset() {
+	*x = GetTickReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickReply) ProtoMessage() {}
+
+func (x *GetTickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickReply.ProtoReflect.Descriptor instead.
+func (*GetTickReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTickReply) GetTick() *Tick {
+	if x != nil {
+		return x.Tick
+	}
+	return nil
+}
+
+type ListTicksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// terc-20, ierc-20 or ierc-pow. empty for all
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// tick name prefix
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// default: 20, max: 100
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListTicksRequest) Reset() {
+	*x = ListTicksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTicksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicksRequest) ProtoMessage() {}
+
+func (x *ListTicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicksRequest.ProtoReflect.Descriptor instead.
+func (*ListTicksRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTicksRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ListTicksRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListTicksRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListTicksRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListTicksReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sorted by tick
+	Ticks []*Tick `protobuf:"bytes,1,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListTicksReply) Reset() {
+	*x = ListTicksReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTicksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicksReply) ProtoMessage() {}
+
+func (x *ListTicksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicksReply.ProtoReflect.Descriptor instead.
+func (*ListTicksReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListTicksReply) GetTicks() []*Tick {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+func (x *ListTicksReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type StakingPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool       string   `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	PoolSubId  uint64   `protobuf:"varint,2,opt,name=pool_sub_id,json=poolSubId,proto3" json:"pool_sub_id,omitempty"`
+	Name       string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Owner      string   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Admins     []string `protobuf:"bytes,5,rep,name=admins,proto3" json:"admins,omitempty"`
+	StartBlock uint64   `protobuf:"varint,6,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// 0 for a pool without time limit
+	StopBlock uint64 `protobuf:"varint,7,opt,name=stop_block,json=stopBlock,proto3" json:"stop_block,omitempty"`
+	// ordered as configured
+	TickDetails      []*StakingPool_TickDetail `protobuf:"bytes,8,rep,name=tick_details,json=tickDetails,proto3" json:"tick_details,omitempty"`
+	LastUpdatedBlock uint64                    `protobuf:"varint,9,opt,name=last_updated_block,json=lastUpdatedBlock,proto3" json:"last_updated_block,omitempty"`
+}
+
+func (x *StakingPool) Reset() {
+	*x = StakingPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingPool) ProtoMessage() {}
+
+func (x *StakingPool) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingPool.ProtoReflect.Descriptor instead.
+func (*StakingPool) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StakingPool) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *StakingPool) GetPoolSubId() uint64 {
+	if x != nil {
+		return x.PoolSubId
+	}
+	return 0
+}
+
+func (x *StakingPool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StakingPool) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *StakingPool) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *StakingPool) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *StakingPool) GetStopBlock() uint64 {
+	if x != nil {
+		return x.StopBlock
+	}
+	return 0
+}
+
+func (x *StakingPool) GetTickDetails() []*StakingPool_TickDetail {
+	if x != nil {
+		return x.TickDetails
+	}
+	return nil
+}
+
+func (x *StakingPool) GetLastUpdatedBlock() uint64 {
+	if x != nil {
+		return x.LastUpdatedBlock
+	}
+	return 0
+}
+
+type StakingPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool      string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	PoolSubId uint64 `protobuf:"varint,2,opt,name=pool_sub_id,json=poolSubId,proto3" json:"pool_sub_id,omitempty"`
+	Staker    string `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
+	// sorted by tick
+	TickDetails     []*StakingPosition_TickDetail `protobuf:"bytes,4,rep,name=tick_details,json=tickDetails,proto3" json:"tick_details,omitempty"`
+	RewardsPerBlock string                        `protobuf:"bytes,5,opt,name=rewards_per_block,json=rewardsPerBlock,proto3" json:"rewards_per_block,omitempty"`
+	AccRewards      string                        `protobuf:"bytes,6,opt,name=acc_rewards,json=accRewards,proto3" json:"acc_rewards,omitempty"`
+	// rewards already used by mints
+	Debt string `protobuf:"bytes,7,opt,name=debt,proto3" json:"debt,omitempty"`
+	// rewards available at rewards_block, the points of a dPoS mint
+	AvailableRewards string `protobuf:"bytes,8,opt,name=available_rewards,json=availableRewards,proto3" json:"available_rewards,omitempty"`
+	RewardsBlock     uint64 `protobuf:"varint,9,opt,name=rewards_block,json=rewardsBlock,proto3" json:"rewards_block,omitempty"`
+	LastRewardBlock  uint64 `protobuf:"varint,10,opt,name=last_reward_block,json=lastRewardBlock,proto3" json:"last_reward_block,omitempty"`
+	LastUpdatedBlock uint64 `protobuf:"varint,11,opt,name=last_updated_block,json=lastUpdatedBlock,proto3" json:"last_updated_block,omitempty"`
+}
+
+func (x *StakingPosition) Reset() {
+	*x = StakingPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingPosition) ProtoMessage() {}
+
+func (x *StakingPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingPosition.ProtoReflect.Descriptor instead.
+func (*StakingPosition) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StakingPosition) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *StakingPosition) GetPoolSubId() uint64 {
+	if x != nil {
+		return x.PoolSubId
+	}
+	return 0
+}
+
+func (x *StakingPosition) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *StakingPosition) GetTickDetails() []*StakingPosition_TickDetail {
+	if x != nil {
+		return x.TickDetails
+	}
+	return nil
+}
+
+func (x *StakingPosition) GetRewardsPerBlock() string {
+	if x != nil {
+		return x.RewardsPerBlock
+	}
+	return ""
+}
+
+func (x *StakingPosition) GetAccRewards() string {
+	if x != nil {
+		return x.AccRewards
+	}
+	return ""
+}
+
+func (x *StakingPosition) GetDebt() string {
+	if x != nil {
+		return x.Debt
+	}
+	return ""
+}
+
+func (x *StakingPosition) GetAvailableRewards() string {
+	if x != nil {
+		return x.AvailableRewards
+	}
+	return ""
+}
+
+func (x *StakingPosition) GetRewardsBlock() uint64 {
+	if x != nil {
+		return x.RewardsBlock
+	}
+	return 0
+}
+
+func (x *StakingPosition) GetLastRewardBlock() uint64 {
+	if x != nil {
+		return x.LastRewardBlock
+	}
+	return 0
+}
+
+func (x *StakingPosition) GetLastUpdatedBlock() uint64 {
+	if x != nil {
+		return x.LastUpdatedBlock
+	}
+	return 0
+}
+
+type ListStakingPoolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// filter by owner. empty for all
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// default: 20, max: 100
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListStakingPoolsRequest) Reset() {
+	*x = ListStakingPoolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStakingPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStakingPoolsRequest) ProtoMessage() {}
+
+func (x *ListStakingPoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStakingPoolsRequest.ProtoReflect.Descriptor instead.
+func (*ListStakingPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListStakingPoolsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListStakingPoolsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListStakingPoolsRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListStakingPoolsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pools []*StakingPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListStakingPoolsReply) Reset() {
+	*x = ListStakingPoolsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStakingPoolsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStakingPoolsReply) ProtoMessage() {}
+
+func (x *ListStakingPoolsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStakingPoolsReply.ProtoReflect.Descriptor instead.
+func (*ListStakingPoolsReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListStakingPoolsReply) GetPools() []*StakingPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *ListStakingPoolsReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetStakingPoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool      string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	PoolSubId uint64 `protobuf:"varint,2,opt,name=pool_sub_id,json=poolSubId,proto3" json:"pool_sub_id,omitempty"`
+}
+
+func (x *GetStakingPoolRequest) Reset() {
+	*x = GetStakingPoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStakingPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStakingPoolRequest) ProtoMessage() {}
+
+func (x *GetStakingPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStakingPoolRequest.ProtoReflect.Descriptor instead.
+func (*GetStakingPoolRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStakingPoolRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *GetStakingPoolRequest) GetPoolSubId() uint64 {
+	if x != nil {
+		return x.PoolSubId
+	}
+	return 0
+}
+
+type GetStakingPoolReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool *StakingPool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (x *GetStakingPoolReply) Reset() {
+	*x = GetStakingPoolReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStakingPoolReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStakingPoolReply) ProtoMessage() {}
+
+func (x *GetStakingPoolReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStakingPoolReply.ProtoReflect.Descriptor instead.
+func (*GetStakingPoolReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStakingPoolReply) GetPool() *StakingPool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+type ListStakingPositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// staker or pool is required
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	Pool   string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// default: 20, max: 100
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListStakingPositionsRequest) Reset() {
+	*x = ListStakingPositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStakingPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStakingPositionsRequest) ProtoMessage() {}
+
+func (x *ListStakingPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStakingPositionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStakingPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListStakingPositionsRequest) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *ListStakingPositionsRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *ListStakingPositionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListStakingPositionsRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListStakingPositionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions []*StakingPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListStakingPositionsReply) Reset() {
+	*x = ListStakingPositionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStakingPositionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStakingPositionsReply) ProtoMessage() {}
+
+func (x *ListStakingPositionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStakingPositionsReply.ProtoReflect.Descriptor instead.
+func (*ListStakingPositionsReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListStakingPositionsReply) GetPositions() []*StakingPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *ListStakingPositionsReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// a freeze_sell order
+type Listing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// listed, sold or cancelled
+	Status   string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Sign     string `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Tick     string `protobuf:"bytes,4,opt,name=tick,proto3" json:"tick,omitempty"`
+	Seller   string `protobuf:"bytes,5,opt,name=seller,proto3" json:"seller,omitempty"`
+	Platform string `protobuf:"bytes,6,opt,name=platform,proto3" json:"platform,omitempty"`
+	Amount   string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	// eth value of the amount
+	Value string `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+	// value / amount
+	Price        string `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	ListedTxHash string `protobuf:"bytes,10,opt,name=listed_tx_hash,json=listedTxHash,proto3" json:"listed_tx_hash,omitempty"`
+	ListedBlock  uint64 `protobuf:"varint,11,opt,name=listed_block,json=listedBlock,proto3" json:"listed_block,omitempty"`
+	// set when sold
+	Buyer string `protobuf:"bytes,12,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// set when sold or cancelled
+	ClosedTxHash string `protobuf:"bytes,13,opt,name=closed_tx_hash,json=closedTxHash,proto3" json:"closed_tx_hash,omitempty"`
+	ClosedBlock  uint64 `protobuf:"varint,14,opt,name=closed_block,json=closedBlock,proto3" json:"closed_block,omitempty"`
+}
+
+func (x *Listing) Reset() {
+	*x = Listing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing) ProtoMessage() {}
+
+func (x *Listing) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing.ProtoReflect.Descriptor instead.
+func (*Listing) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Listing) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Listing) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+func (x *Listing) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Listing) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *Listing) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *Listing) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *Listing) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Listing) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Listing) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Listing) GetListedTxHash() string {
+	if x != nil {
+		return x.ListedTxHash
+	}
+	return ""
+}
+
+func (x *Listing) GetListedBlock() uint64 {
+	if x != nil {
+		return x.ListedBlock
+	}
+	return 0
+}
+
+func (x *Listing) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *Listing) GetClosedTxHash() string {
+	if x != nil {
+		return x.ClosedTxHash
+	}
+	return ""
+}
+
+func (x *Listing) GetClosedBlock() uint64 {
+	if x != nil {
+		return x.ClosedBlock
+	}
+	return 0
+}
+
+type GetListingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sign string `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *GetListingRequest) Reset() {
+	*x = GetListingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingRequest) ProtoMessage() {}
+
+func (x *GetListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingRequest.ProtoReflect.Descriptor instead.
+func (*GetListingRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetListingRequest) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+type GetListingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the latest listing of the signature
+	Listing *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+}
+
+func (x *GetListingReply) Reset() {
+	*x = GetListingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingReply) ProtoMessage() {}
+
+func (x *GetListingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingReply.ProtoReflect.Descriptor instead.
+func (*GetListingReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetListingReply) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type ListListingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// filter by seller. empty for all
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// listed, sold or cancelled. empty for all
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// sort by price ascending instead of listing order
+	OrderByPrice bool `protobuf:"varint,4,opt,name=order_by_price,json=orderByPrice,proto3" json:"order_by_price,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// default: 20, max: 100
+	Size int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListListingsRequest) Reset() {
+	*x = ListListingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListingsRequest) ProtoMessage() {}
+
+func (x *ListListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListingsRequest.ProtoReflect.Descriptor instead.
+func (*ListListingsRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListListingsRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *ListListingsRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *ListListingsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListListingsRequest) GetOrderByPrice() bool {
+	if x != nil {
+		return x.OrderByPrice
+	}
+	return false
+}
+
+func (x *ListListingsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListListingsRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListListingsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listings []*Listing `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListListingsReply) Reset() {
+	*x = ListListingsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListListingsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListingsReply) ProtoMessage() {}
+
+func (x *ListListingsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListingsReply.ProtoReflect.Descriptor instead.
+func (*ListListingsReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListListingsReply) GetListings() []*Listing {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *ListListingsReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// statistics of a tick over a block or a UTC day
+type TickStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// the last block of the period
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// yyyy-mm-dd
+	Day string `protobuf:"bytes,3,opt,name=day,proto3" json:"day,omitempty"`
+	// activity of the period
+	HolderDelta int64  `protobuf:"varint,4,opt,name=holder_delta,json=holderDelta,proto3" json:"holder_delta,omitempty"`
+	Transfers   int64  `protobuf:"varint,5,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	Mints       int64  `protobuf:"varint,6,opt,name=mints,proto3" json:"mints,omitempty"`
+	Minted      string `protobuf:"bytes,7,opt,name=minted,proto3" json:"minted,omitempty"`
+	// completed proxy_transfer
+	Trades int64 `protobuf:"varint,8,opt,name=trades,proto3" json:"trades,omitempty"`
+	// eth value of the trades
+	Volume string `protobuf:"bytes,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	// values at the end of the period
+	Holders        int64  `protobuf:"varint,10,opt,name=holders,proto3" json:"holders,omitempty"`
+	TotalTransfers int64  `protobuf:"varint,11,opt,name=total_transfers,json=totalTransfers,proto3" json:"total_transfers,omitempty"`
+	TotalMints     int64  `protobuf:"varint,12,opt,name=total_mints,json=totalMints,proto3" json:"total_mints,omitempty"`
+	TotalMinted    string `protobuf:"bytes,13,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted,omitempty"`
+	TotalTrades    int64  `protobuf:"varint,14,opt,name=total_trades,json=totalTrades,proto3" json:"total_trades,omitempty"`
+	TotalVolume    string `protobuf:"bytes,15,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	// eth value per unit of the last trade
+	LastPrice string `protobuf:"bytes,16,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+}
+
+func (x *TickStats) Reset() {
+	*x = TickStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TickStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickStats) ProtoMessage() {}
+
+func (x *TickStats) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickStats.ProtoReflect.Descriptor instead.
+func (*TickStats) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TickStats) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *TickStats) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *TickStats) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *TickStats) GetHolderDelta() int64 {
+	if x != nil {
+		return x.HolderDelta
+	}
+	return 0
+}
+
+func (x *TickStats) GetTransfers() int64 {
+	if x != nil {
+		return x.Transfers
+	}
+	return 0
+}
+
+func (x *TickStats) GetMints() int64 {
+	if x != nil {
+		return x.Mints
+	}
+	return 0
+}
+
+func (x *TickStats) GetMinted() string {
+	if x != nil {
+		return x.Minted
+	}
+	return ""
+}
+
+func (x *TickStats) GetTrades() int64 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+func (x *TickStats) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *TickStats) GetHolders() int64 {
+	if x != nil {
+		return x.Holders
+	}
+	return 0
+}
+
+func (x *TickStats) GetTotalTransfers() int64 {
+	if x != nil {
+		return x.TotalTransfers
+	}
+	return 0
+}
+
+func (x *TickStats) GetTotalMints() int64 {
+	if x != nil {
+		return x.TotalMints
+	}
+	return 0
+}
+
+func (x *TickStats) GetTotalMinted() string {
+	if x != nil {
+		return x.TotalMinted
+	}
+	return ""
+}
+
+func (x *TickStats) GetTotalTrades() int64 {
+	if x != nil {
+		return x.TotalTrades
+	}
+	return 0
+}
+
+func (x *TickStats) GetTotalVolume() string {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return ""
+}
+
+func (x *TickStats) GetLastPrice() string {
+	if x != nil {
+		return x.LastPrice
+	}
+	return ""
+}
+
+type GetTickStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *GetTickStatsRequest) Reset() {
+	*x = GetTickStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickStatsRequest) ProtoMessage() {}
+
+func (x *GetTickStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTickStatsRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetTickStatsRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+type GetTickStatsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// at the latest block changing the tick
+	Stats *TickStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetTickStatsReply) Reset() {
+	*x = GetTickStatsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickStatsReply) ProtoMessage() {}
+
+func (x *GetTickStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickStatsReply.ProtoReflect.Descriptor instead.
+func (*GetTickStatsReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetTickStatsReply) GetStats() *TickStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ListTickStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// block or day, default: day
+	Period string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	// next_cursor of the previous page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// default: 20, max: 100
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListTickStatsRequest) Reset() {
+	*x = ListTickStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTickStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTickStatsRequest) ProtoMessage() {}
+
+func (x *ListTickStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTickStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListTickStatsRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListTickStatsRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *ListTickStatsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ListTickStatsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListTickStatsRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListTickStatsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// latest first, starting from the block the statistics were created at
+	Stats []*TickStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListTickStatsReply) Reset() {
+	*x = ListTickStatsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTickStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTickStatsReply) ProtoMessage() {}
+
+func (x *ListTickStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTickStatsReply.ProtoReflect.Descriptor instead.
+func (*ListTickStatsReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListTickStatsReply) GetStats() []*TickStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *ListTickStatsReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// parsed inscription of a transaction
+type IERCTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol string  `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Operate  Operate `protobuf:"varint,2,opt,name=operate,proto3,enum=api.indexer.Operate" json:"operate,omitempty"`
+	// Types that are assignable to Command:
+	//	*IERCTransaction_Deploy_
+	//	*IERCTransaction_Mint_
+	//	*IERCTransaction_DeployPow
+	//	*IERCTransaction_MintPow
+	//	*IERCTransaction_Transfer_
+	//	*IERCTransaction_FreezeSell_
+	//	*IERCTransaction_UnfreezeSell_
+	//	*IERCTransaction_ProxyTransfer_
+	//	*IERCTransaction_ConfigStake_
+	//	*IERCTransaction_Staking_
+	//	*IERCTransaction_Modify_
+	//	*IERCTransaction_ClaimAirdrop_
+	Command isIERCTransaction_Command `protobuf_oneof:"command"`
+}
+
+func (x *IERCTransaction) Reset() {
+	*x = IERCTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction) ProtoMessage() {}
+
+func (x *IERCTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction.ProtoReflect.Descriptor instead.
+func (*IERCTransaction) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IERCTransaction) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *IERCTransaction) GetOperate() Operate {
+	if x != nil {
+		return x.Operate
+	}
+	return Operate_OPERATE_UNSPECIFIED
+}
+
+func (m *IERCTransaction) GetCommand() isIERCTransaction_Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetDeploy() *IERCTransaction_Deploy {
+	if x, ok := x.GetCommand().(*IERCTransaction_Deploy_); ok {
+		return x.Deploy
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetMint() *IERCTransaction_Mint {
+	if x, ok := x.GetCommand().(*IERCTransaction_Mint_); ok {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetDeployPow() *IERCTransaction_DeployPoW {
+	if x, ok := x.GetCommand().(*IERCTransaction_DeployPow); ok {
+		return x.DeployPow
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetMintPow() *IERCTransaction_MintPoW {
+	if x, ok := x.GetCommand().(*IERCTransaction_MintPow); ok {
+		return x.MintPow
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetTransfer() *IERCTransaction_Transfer {
+	if x, ok := x.GetCommand().(*IERCTransaction_Transfer_); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetFreezeSell() *IERCTransaction_FreezeSell {
+	if x, ok := x.GetCommand().(*IERCTransaction_FreezeSell_); ok {
+		return x.FreezeSell
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetUnfreezeSell() *IERCTransaction_UnfreezeSell {
+	if x, ok := x.GetCommand().(*IERCTransaction_UnfreezeSell_); ok {
+		return x.UnfreezeSell
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetProxyTransfer() *IERCTransaction_ProxyTransfer {
+	if x, ok := x.GetCommand().(*IERCTransaction_ProxyTransfer_); ok {
+		return x.ProxyTransfer
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetConfigStake() *IERCTransaction_ConfigStake {
+	if x, ok := x.GetCommand().(*IERCTransaction_ConfigStake_); ok {
+		return x.ConfigStake
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetStaking() *IERCTransaction_Staking {
+	if x, ok := x.GetCommand().(*IERCTransaction_Staking_); ok {
+		return x.Staking
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetModify() *IERCTransaction_Modify {
+	if x, ok := x.GetCommand().(*IERCTransaction_Modify_); ok {
+		return x.Modify
+	}
+	return nil
+}
+
+func (x *IERCTransaction) GetClaimAirdrop() *IERCTransaction_ClaimAirdrop {
+	if x, ok := x.GetCommand().(*IERCTransaction_ClaimAirdrop_); ok {
+		return x.ClaimAirdrop
+	}
+	return nil
+}
+
+type isIERCTransaction_Command interface {
+	isIERCTransaction_Command()
+}
+
+type IERCTransaction_Deploy_ struct {
+	Deploy *IERCTransaction_Deploy `protobuf:"bytes,3,opt,name=deploy,proto3,oneof"`
+}
+
+type IERCTransaction_Mint_ struct {
+	Mint *IERCTransaction_Mint `protobuf:"bytes,4,opt,name=mint,proto3,oneof"`
+}
+
+type IERCTransaction_DeployPow struct {
+	DeployPow *IERCTransaction_DeployPoW `protobuf:"bytes,5,opt,name=deploy_pow,json=deployPow,proto3,oneof"`
+}
+
+type IERCTransaction_MintPow struct {
+	MintPow *IERCTransaction_MintPoW `protobuf:"bytes,6,opt,name=mint_pow,json=mintPow,proto3,oneof"`
+}
+
+type IERCTransaction_Transfer_ struct {
+	Transfer *IERCTransaction_Transfer `protobuf:"bytes,7,opt,name=transfer,proto3,oneof"`
+}
+
+type IERCTransaction_FreezeSell_ struct {
+	FreezeSell *IERCTransaction_FreezeSell `protobuf:"bytes,8,opt,name=freeze_sell,json=freezeSell,proto3,oneof"`
+}
+
+type IERCTransaction_UnfreezeSell_ struct {
+	UnfreezeSell *IERCTransaction_UnfreezeSell `protobuf:"bytes,9,opt,name=unfreeze_sell,json=unfreezeSell,proto3,oneof"`
+}
+
+type IERCTransaction_ProxyTransfer_ struct {
+	ProxyTransfer *IERCTransaction_ProxyTransfer `protobuf:"bytes,10,opt,name=proxy_transfer,json=proxyTransfer,proto3,oneof"`
+}
+
+type IERCTransaction_ConfigStake_ struct {
+	ConfigStake *IERCTransaction_ConfigStake `protobuf:"bytes,11,opt,name=config_stake,json=configStake,proto3,oneof"`
+}
+
+type IERCTransaction_Staking_ struct {
+	Staking *IERCTransaction_Staking `protobuf:"bytes,12,opt,name=staking,proto3,oneof"`
+}
+
+type IERCTransaction_Modify_ struct {
+	Modify *IERCTransaction_Modify `protobuf:"bytes,13,opt,name=modify,proto3,oneof"`
+}
+
+type IERCTransaction_ClaimAirdrop_ struct {
+	ClaimAirdrop *IERCTransaction_ClaimAirdrop `protobuf:"bytes,14,opt,name=claim_airdrop,json=claimAirdrop,proto3,oneof"`
+}
+
+func (*IERCTransaction_Deploy_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_Mint_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_DeployPow) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_MintPow) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_Transfer_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_FreezeSell_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_UnfreezeSell_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_ProxyTransfer_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_ConfigStake_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_Staking_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_Modify_) isIERCTransaction_Command() {}
+
+func (*IERCTransaction_ClaimAirdrop_) isIERCTransaction_Command() {}
+
+type Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// position in the block
+	Position    int64  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Hash        string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	From        string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To          string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Value       string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	Gas         string `protobuf:"bytes,7,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasPrice    string `protobuf:"bytes,8,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Nonce       uint64 `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Data        string `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	IsProcessed bool   `protobuf:"varint,11,opt,name=is_processed,json=isProcessed,proto3" json:"is_processed,omitempty"`
+	// 0 for success
+	Code   int32  `protobuf:"varint,12,opt,name=code,proto3" json:"code,omitempty"`
+	Remark string `protobuf:"bytes,13,opt,name=remark,proto3" json:"remark,omitempty"`
+	// empty if the inscription can not be parsed, see code and remark
+	IercTransaction *IERCTransaction `protobuf:"bytes,14,opt,name=ierc_transaction,json=iercTransaction,proto3" json:"ierc_transaction,omitempty"`
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Transaction) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *Transaction) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Transaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Transaction) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Transaction) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Transaction) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Transaction) GetGas() string {
+	if x != nil {
+		return x.Gas
+	}
+	return ""
+}
+
+func (x *Transaction) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *Transaction) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *Transaction) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *Transaction) GetIsProcessed() bool {
+	if x != nil {
+		return x.IsProcessed
+	}
+	return false
+}
+
+func (x *Transaction) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Transaction) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *Transaction) GetIercTransaction() *IERCTransaction {
+	if x != nil {
+		return x.IercTransaction
+	}
+	return nil
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type GetTransactionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// events produced by the transaction, ordered by pos_in_ierc_txs
+	Events []*Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetTransactionReply) Reset() {
+	*x = GetTransactionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionReply) ProtoMessage() {}
+
+func (x *GetTransactionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionReply.ProtoReflect.Descriptor instead.
+func (*GetTransactionReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetTransactionReply) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *GetTransactionReply) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// webhook endpoint. events matched by the filters are posted to url as SubscribeReply json,
+// signed by header X-Indexer-Signature: sha256=hex(hmac_sha256(secret, X-Indexer-Timestamp + "." + body)).
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// filters, empty matches all. an event matches an address when it is the ierc from or to.
+	Ticks         []string  `protobuf:"bytes,3,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	Addresses     []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Operates      []Operate `protobuf:"varint,5,rep,packed,name=operates,proto3,enum=api.indexer.Operate" json:"operates,omitempty"`
+	IncludeFailed bool      `protobuf:"varint,6,opt,name=include_failed,json=includeFailed,proto3" json:"include_failed,omitempty"`
+	// unix milliseconds
+	CreatedAt int64 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Webhook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetTicks() []string {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+func (x *Webhook) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Webhook) GetOperates() []Operate {
+	if x != nil {
+		return x.Operates
+	}
+	return nil
+}
+
+func (x *Webhook) GetIncludeFailed() bool {
+	if x != nil {
+		return x.IncludeFailed
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url           string    `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Ticks         []string  `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	Addresses     []string  `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Operates      []Operate `protobuf:"varint,4,rep,packed,name=operates,proto3,enum=api.indexer.Operate" json:"operates,omitempty"`
+	IncludeFailed bool      `protobuf:"varint,5,opt,name=include_failed,json=includeFailed,proto3" json:"include_failed,omitempty"`
+	// hmac secret of the deliveries, generated when empty.
+	Secret string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetTicks() []string {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetOperates() []Operate {
+	if x != nil {
+		return x.Operates
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetIncludeFailed() bool {
+	if x != nil {
+		return x.IncludeFailed
+	}
+	return false
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// returned on creation only.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookReply) Reset() {
+	*x = CreateWebhookReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookReply) ProtoMessage() {}
+
+func (x *CreateWebhookReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookReply.ProtoReflect.Descriptor instead.
+func (*CreateWebhookReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateWebhookReply) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{52}
+}
+
+type ListWebhooksReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListWebhooksReply) Reset() {
+	*x = ListWebhooksReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksReply) ProtoMessage() {}
+
+func (x *ListWebhooksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksReply.ProtoReflect.Descriptor instead.
+func (*ListWebhooksReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListWebhooksReply) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWebhookReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWebhookReply) Reset() {
+	*x = DeleteWebhookReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookReply) ProtoMessage() {}
+
+func (x *DeleteWebhookReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookReply.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{55}
+}
+
+type QueryEventsReply_EventsByBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber     uint64   `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	PrevBlockNumber uint64   `protobuf:"varint,2,opt,name=prev_block_number,json=prevBlockNumber,proto3" json:"prev_block_number,omitempty"`
+	Events          []*Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *QueryEventsReply_EventsByBlock) Reset() {
+	*x = QueryEventsReply_EventsByBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEventsReply_EventsByBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEventsReply_EventsByBlock) ProtoMessage() {}
+
+func (x *QueryEventsReply_EventsByBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEventsReply_EventsByBlock.ProtoReflect.Descriptor instead.
+func (*QueryEventsReply_EventsByBlock) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *QueryEventsReply_EventsByBlock) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *QueryEventsReply_EventsByBlock) GetPrevBlockNumber() uint64 {
+	if x != nil {
+		return x.PrevBlockNumber
+	}
+	return 0
+}
+
+func (x *QueryEventsReply_EventsByBlock) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CheckTransferReply_TransferRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Tick     string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	Amount   string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status   bool   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CheckTransferReply_TransferRecord) Reset() {
+	*x = CheckTransferReply_TransferRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckTransferReply_TransferRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTransferReply_TransferRecord) ProtoMessage() {}
+
+func (x *CheckTransferReply_TransferRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTransferReply_TransferRecord.ProtoReflect.Descriptor instead.
+func (*CheckTransferReply_TransferRecord) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *CheckTransferReply_TransferRecord) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *CheckTransferReply_TransferRecord) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *CheckTransferReply_TransferRecord) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *CheckTransferReply_TransferRecord) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CheckTransferReply_TransferRecord) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+type Tick_IERC20Detail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit       string `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	WalletLimit string `protobuf:"bytes,2,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty"`
+	Workc       string `protobuf:"bytes,3,opt,name=workc,proto3" json:"workc,omitempty"`
+}
+
+func (x *Tick_IERC20Detail) Reset() {
+	*x = Tick_IERC20Detail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tick_IERC20Detail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tick_IERC20Detail) ProtoMessage() {}
+
+func (x *Tick_IERC20Detail) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tick_IERC20Detail.ProtoReflect.Descriptor instead.
+func (*Tick_IERC20Detail) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *Tick_IERC20Detail) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+func (x *Tick_IERC20Detail) GetWalletLimit() string {
+	if x != nil {
+		return x.WalletLimit
+	}
+	return ""
+}
+
+func (x *Tick_IERC20Detail) GetWorkc() string {
+	if x != nil {
+		return x.Workc
+	}
+	return ""
+}
+
+type Tick_IERCPoWDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenomicsDetails []*IERCPoWTickCreated_TokenomicsDetail `protobuf:"bytes,1,rep,name=tokenomics_details,json=tokenomicsDetails,proto3" json:"tokenomics_details,omitempty"`
+	Rule              *IERCPoWTickCreated_Rule               `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	PowSupply         string                                 `protobuf:"bytes,3,opt,name=pow_supply,json=powSupply,proto3" json:"pow_supply,omitempty"`
+	PosSupply         string                                 `protobuf:"bytes,4,opt,name=pos_supply,json=posSupply,proto3" json:"pos_supply,omitempty"`
+	AirdropAmount     string                                 `protobuf:"bytes,5,opt,name=airdrop_amount,json=airdropAmount,proto3" json:"airdrop_amount,omitempty"`
+	PowRemainSupply   string                                 `protobuf:"bytes,6,opt,name=pow_remain_supply,json=powRemainSupply,proto3" json:"pow_remain_supply,omitempty"`
+	PosRemainSupply   string                                 `protobuf:"bytes,7,opt,name=pos_remain_supply,json=posRemainSupply,proto3" json:"pos_remain_supply,omitempty"`
+	// max_supply - supply
+	RemainSupply string `protobuf:"bytes,8,opt,name=remain_supply,json=remainSupply,proto3" json:"remain_supply,omitempty"`
+	// supply calculated at projected_block, including the amount can be minted or burned since the last mint
+	ProjectedSupply string `protobuf:"bytes,9,opt,name=projected_supply,json=projectedSupply,proto3" json:"projected_supply,omitempty"`
+	ProjectedBlock  uint64 `protobuf:"varint,10,opt,name=projected_block,json=projectedBlock,proto3" json:"projected_block,omitempty"`
+}
+
+func (x *Tick_IERCPoWDetail) Reset() {
+	*x = Tick_IERCPoWDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tick_IERCPoWDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tick_IERCPoWDetail) ProtoMessage() {}
+
+func (x *Tick_IERCPoWDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tick_IERCPoWDetail.ProtoReflect.Descriptor instead.
+func (*Tick_IERCPoWDetail) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *Tick_IERCPoWDetail) GetTokenomicsDetails() []*IERCPoWTickCreated_TokenomicsDetail {
+	if x != nil {
+		return x.TokenomicsDetails
+	}
+	return nil
+}
+
+func (x *Tick_IERCPoWDetail) GetRule() *IERCPoWTickCreated_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *Tick_IERCPoWDetail) GetPowSupply() string {
+	if x != nil {
+		return x.PowSupply
+	}
+	return ""
+}
+
+func (x *Tick_IERCPoWDetail) GetPosSupply() string {
+	if x != nil {
+		return x.PosSupply
+	}
+	return ""
+}
+
+func (x *Tick_IERCPoWDetail) GetAirdropAmount() string {
+	if x != nil {
+		return x.AirdropAmount
+	}
+	return ""
+}
+
+func (x *Tick_IERCPoWDetail) GetPowRemainSupply() string {
+	if x != nil {
+		return x.PowRemainSupply
+	}
+	return ""
+}
+
+func (x *Tick_IERCPoWDetail) GetPosRemainSupply() string {
+	if x != nil {
+		return x.PosRemainSupply
+	}
+	return ""
+}
+
+func (x *Tick_IERCPoWDetail) GetRemainSupply() string {
+	if x != nil {
+		return x.RemainSupply
+	}
+	return ""
+}
+
+func (x *Tick_IERCPoWDetail) GetProjectedSupply() string {
+	if x != nil {
+		return x.ProjectedSupply
+	}
+	return ""
+}
+
+func (x *Tick_IERCPoWDetail) GetProjectedBlock() uint64 {
+	if x != nil {
+		return x.ProjectedBlock
+	}
+	return 0
+}
+
+type StakingPool_TickDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// rewards per block of one staked tick
+	Ratio         string `protobuf:"bytes,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	MaxAmount     string `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	HistoryAmount string `protobuf:"bytes,5,opt,name=history_amount,json=historyAmount,proto3" json:"history_amount,omitempty"`
+}
+
+func (x *StakingPool_TickDetail) Reset() {
+	*x = StakingPool_TickDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingPool_TickDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingPool_TickDetail) ProtoMessage() {}
+
+func (x *StakingPool_TickDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingPool_TickDetail.ProtoReflect.Descriptor instead.
+func (*StakingPool_TickDetail) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *StakingPool_TickDetail) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *StakingPool_TickDetail) GetRatio() string {
+	if x != nil {
+		return x.Ratio
+	}
+	return ""
+}
+
+func (x *StakingPool_TickDetail) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StakingPool_TickDetail) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+func (x *StakingPool_TickDetail) GetHistoryAmount() string {
+	if x != nil {
+		return x.HistoryAmount
+	}
+	return ""
+}
+
+type StakingPosition_TickDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick   string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Ratio  string `protobuf:"bytes,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *StakingPosition_TickDetail) Reset() {
+	*x = StakingPosition_TickDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingPosition_TickDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingPosition_TickDetail) ProtoMessage() {}
+
+func (x *StakingPosition_TickDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingPosition_TickDetail.ProtoReflect.Descriptor instead.
+func (*StakingPosition_TickDetail) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *StakingPosition_TickDetail) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *StakingPosition_TickDetail) GetRatio() string {
+	if x != nil {
+		return x.Ratio
+	}
+	return ""
+}
+
+func (x *StakingPosition_TickDetail) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type IERCTransaction_Deploy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick        string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Decimals    int64  `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	MaxSupply   string `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	Limit       string `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	WalletLimit string `protobuf:"bytes,5,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty"`
+	Workc       string `protobuf:"bytes,6,opt,name=workc,proto3" json:"workc,omitempty"`
+	Nonce       string `protobuf:"bytes,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *IERCTransaction_Deploy) Reset() {
+	*x = IERCTransaction_Deploy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_Deploy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_Deploy) ProtoMessage() {}
+
+func (x *IERCTransaction_Deploy) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_Deploy.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_Deploy) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *IERCTransaction_Deploy) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Deploy) GetDecimals() int64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *IERCTransaction_Deploy) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Deploy) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Deploy) GetWalletLimit() string {
+	if x != nil {
+		return x.WalletLimit
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Deploy) GetWorkc() string {
+	if x != nil {
+		return x.Workc
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Deploy) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type IERCTransaction_Mint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick   string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Nonce  string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *IERCTransaction_Mint) Reset() {
+	*x = IERCTransaction_Mint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_Mint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_Mint) ProtoMessage() {}
+
+func (x *IERCTransaction_Mint) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_Mint.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_Mint) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 1}
+}
+
+func (x *IERCTransaction_Mint) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Mint) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Mint) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type IERCTransaction_DeployPoW struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick              string                                 `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Decimals          int64                                  `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	MaxSupply         string                                 `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	TokenomicsDetails []*IERCPoWTickCreated_TokenomicsDetail `protobuf:"bytes,4,rep,name=tokenomics_details,json=tokenomicsDetails,proto3" json:"tokenomics_details,omitempty"`
+	Rule              *IERCPoWTickCreated_Rule               `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *IERCTransaction_DeployPoW) Reset() {
+	*x = IERCTransaction_DeployPoW{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_DeployPoW) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_DeployPoW) ProtoMessage() {}
+
+func (x *IERCTransaction_DeployPoW) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_DeployPoW.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_DeployPoW) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 2}
+}
+
+func (x *IERCTransaction_DeployPoW) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_DeployPoW) GetDecimals() int64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *IERCTransaction_DeployPoW) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *IERCTransaction_DeployPoW) GetTokenomicsDetails() []*IERCPoWTickCreated_TokenomicsDetail {
+	if x != nil {
+		return x.TokenomicsDetails
+	}
+	return nil
+}
+
+func (x *IERCTransaction_DeployPoW) GetRule() *IERCPoWTickCreated_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type IERCTransaction_MintPoW struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// dPoS points
+	Points string `protobuf:"bytes,2,opt,name=points,proto3" json:"points,omitempty"`
+	// effective block of a PoW mint
+	Block uint64 `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Nonce string `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *IERCTransaction_MintPoW) Reset() {
+	*x = IERCTransaction_MintPoW{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_MintPoW) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_MintPoW) ProtoMessage() {}
+
+func (x *IERCTransaction_MintPoW) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_MintPoW.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_MintPoW) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 3}
+}
+
+func (x *IERCTransaction_MintPoW) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_MintPoW) GetPoints() string {
+	if x != nil {
+		return x.Points
+	}
+	return ""
+}
+
+func (x *IERCTransaction_MintPoW) GetBlock() uint64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *IERCTransaction_MintPoW) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type IERCTransaction_TransferRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick   string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	From   string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *IERCTransaction_TransferRecord) Reset() {
+	*x = IERCTransaction_TransferRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_TransferRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_TransferRecord) ProtoMessage() {}
+
+func (x *IERCTransaction_TransferRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_TransferRecord.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_TransferRecord) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 4}
+}
+
+func (x *IERCTransaction_TransferRecord) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_TransferRecord) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *IERCTransaction_TransferRecord) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *IERCTransaction_TransferRecord) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type IERCTransaction_FreezeRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick       string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Platform   string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Seller     string `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	SellerSign string `protobuf:"bytes,4,opt,name=seller_sign,json=sellerSign,proto3" json:"seller_sign,omitempty"`
+	SignNonce  string `protobuf:"bytes,5,opt,name=sign_nonce,json=signNonce,proto3" json:"sign_nonce,omitempty"`
+	Amount     string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Value      string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	GasPrice   string `protobuf:"bytes,8,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+}
+
+func (x *IERCTransaction_FreezeRecord) Reset() {
+	*x = IERCTransaction_FreezeRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_FreezeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_FreezeRecord) ProtoMessage() {}
+
+func (x *IERCTransaction_FreezeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_FreezeRecord.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_FreezeRecord) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 5}
+}
+
+func (x *IERCTransaction_FreezeRecord) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_FreezeRecord) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *IERCTransaction_FreezeRecord) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *IERCTransaction_FreezeRecord) GetSellerSign() string {
+	if x != nil {
+		return x.SellerSign
+	}
+	return ""
+}
+
+func (x *IERCTransaction_FreezeRecord) GetSignNonce() string {
+	if x != nil {
+		return x.SignNonce
+	}
+	return ""
+}
+
+func (x *IERCTransaction_FreezeRecord) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *IERCTransaction_FreezeRecord) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *IERCTransaction_FreezeRecord) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+type IERCTransaction_UnfreezeRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash   string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Position int32  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Sign     string `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+	Msg      string `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *IERCTransaction_UnfreezeRecord) Reset() {
+	*x = IERCTransaction_UnfreezeRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_UnfreezeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_UnfreezeRecord) ProtoMessage() {}
+
+func (x *IERCTransaction_UnfreezeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_UnfreezeRecord.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_UnfreezeRecord) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 6}
+}
+
+func (x *IERCTransaction_UnfreezeRecord) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *IERCTransaction_UnfreezeRecord) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *IERCTransaction_UnfreezeRecord) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+func (x *IERCTransaction_UnfreezeRecord) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type IERCTransaction_ProxyTransferRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick        string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	From        string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Value       string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Sign        string `protobuf:"bytes,6,opt,name=sign,proto3" json:"sign,omitempty"`
+	SignerNonce string `protobuf:"bytes,7,opt,name=signer_nonce,json=signerNonce,proto3" json:"signer_nonce,omitempty"`
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) Reset() {
+	*x = IERCTransaction_ProxyTransferRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_ProxyTransferRecord) ProtoMessage() {}
+
+func (x *IERCTransaction_ProxyTransferRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_ProxyTransferRecord.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_ProxyTransferRecord) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 7}
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ProxyTransferRecord) GetSignerNonce() string {
+	if x != nil {
+		return x.SignerNonce
+	}
+	return ""
+}
+
+type IERCTransaction_ConfigStake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool      string                                 `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	PoolSubId uint64                                 `protobuf:"varint,2,opt,name=pool_sub_id,json=poolSubId,proto3" json:"pool_sub_id,omitempty"`
+	Owner     string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Admins    []string                               `protobuf:"bytes,4,rep,name=admins,proto3" json:"admins,omitempty"`
+	Name      string                                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	StopBlock uint64                                 `protobuf:"varint,6,opt,name=stop_block,json=stopBlock,proto3" json:"stop_block,omitempty"`
+	Details   []*StakingPoolUpdated_TickConfigDetail `protobuf:"bytes,7,rep,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *IERCTransaction_ConfigStake) Reset() {
+	*x = IERCTransaction_ConfigStake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_ConfigStake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_ConfigStake) ProtoMessage() {}
+
+func (x *IERCTransaction_ConfigStake) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_ConfigStake.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_ConfigStake) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 8}
+}
+
+func (x *IERCTransaction_ConfigStake) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ConfigStake) GetPoolSubId() uint64 {
+	if x != nil {
+		return x.PoolSubId
+	}
+	return 0
+}
+
+func (x *IERCTransaction_ConfigStake) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ConfigStake) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *IERCTransaction_ConfigStake) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ConfigStake) GetStopBlock() uint64 {
+	if x != nil {
+		return x.StopBlock
+	}
+	return 0
+}
+
+func (x *IERCTransaction_ConfigStake) GetDetails() []*StakingPoolUpdated_TickConfigDetail {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type IERCTransaction_StakingRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Staker    string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	Pool      string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	PoolSubId uint64 `protobuf:"varint,3,opt,name=pool_sub_id,json=poolSubId,proto3" json:"pool_sub_id,omitempty"`
+	Tick      string `protobuf:"bytes,4,opt,name=tick,proto3" json:"tick,omitempty"`
+	Amount    string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *IERCTransaction_StakingRecord) Reset() {
+	*x = IERCTransaction_StakingRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_StakingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_StakingRecord) ProtoMessage() {}
+
+func (x *IERCTransaction_StakingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_StakingRecord.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_StakingRecord) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 9}
+}
+
+func (x *IERCTransaction_StakingRecord) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *IERCTransaction_StakingRecord) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *IERCTransaction_StakingRecord) GetPoolSubId() uint64 {
+	if x != nil {
+		return x.PoolSubId
+	}
+	return 0
+}
+
+func (x *IERCTransaction_StakingRecord) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_StakingRecord) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type IERCTransaction_Modify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick      string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	MaxSupply string `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+}
+
+func (x *IERCTransaction_Modify) Reset() {
+	*x = IERCTransaction_Modify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_Modify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_Modify) ProtoMessage() {}
+
+func (x *IERCTransaction_Modify) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_Modify.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_Modify) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 10}
+}
+
+func (x *IERCTransaction_Modify) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Modify) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+type IERCTransaction_ClaimAirdrop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick   string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *IERCTransaction_ClaimAirdrop) Reset() {
+	*x = IERCTransaction_ClaimAirdrop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_ClaimAirdrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_ClaimAirdrop) ProtoMessage() {}
+
+func (x *IERCTransaction_ClaimAirdrop) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_ClaimAirdrop.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_ClaimAirdrop) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 11}
+}
+
+func (x *IERCTransaction_ClaimAirdrop) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERCTransaction_ClaimAirdrop) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// one record per position
+type IERCTransaction_Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*IERCTransaction_TransferRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *IERCTransaction_Transfer) Reset() {
+	*x = IERCTransaction_Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_Transfer) ProtoMessage() {}
+
+func (x *IERCTransaction_Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_Transfer.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_Transfer) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 12}
+}
+
+func (x *IERCTransaction_Transfer) GetRecords() []*IERCTransaction_TransferRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type IERCTransaction_FreezeSell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*IERCTransaction_FreezeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *IERCTransaction_FreezeSell) Reset() {
+	*x = IERCTransaction_FreezeSell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_FreezeSell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_FreezeSell) ProtoMessage() {}
+
+func (x *IERCTransaction_FreezeSell) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_FreezeSell.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_FreezeSell) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 13}
+}
+
+func (x *IERCTransaction_FreezeSell) GetRecords() []*IERCTransaction_FreezeRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type IERCTransaction_UnfreezeSell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*IERCTransaction_UnfreezeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *IERCTransaction_UnfreezeSell) Reset() {
+	*x = IERCTransaction_UnfreezeSell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_UnfreezeSell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_UnfreezeSell) ProtoMessage() {}
+
+func (x *IERCTransaction_UnfreezeSell) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_UnfreezeSell.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_UnfreezeSell) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 14}
+}
+
+func (x *IERCTransaction_UnfreezeSell) GetRecords() []*IERCTransaction_UnfreezeRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type IERCTransaction_ProxyTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*IERCTransaction_ProxyTransferRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *IERCTransaction_ProxyTransfer) Reset() {
+	*x = IERCTransaction_ProxyTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_ProxyTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_ProxyTransfer) ProtoMessage() {}
+
+func (x *IERCTransaction_ProxyTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_ProxyTransfer.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_ProxyTransfer) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 15}
+}
+
+func (x *IERCTransaction_ProxyTransfer) GetRecords() []*IERCTransaction_ProxyTransferRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// stake, unstake and proxy_unstake
+type IERCTransaction_Staking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool      string                           `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	PoolSubId uint64                           `protobuf:"varint,2,opt,name=pool_sub_id,json=poolSubId,proto3" json:"pool_sub_id,omitempty"`
+	Records   []*IERCTransaction_StakingRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *IERCTransaction_Staking) Reset() {
+	*x = IERCTransaction_Staking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERCTransaction_Staking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERCTransaction_Staking) ProtoMessage() {}
+
+func (x *IERCTransaction_Staking) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERCTransaction_Staking.ProtoReflect.Descriptor instead.
+func (*IERCTransaction_Staking) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45, 16}
+}
+
+func (x *IERCTransaction_Staking) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *IERCTransaction_Staking) GetPoolSubId() uint64 {
+	if x != nil {
+		return x.PoolSubId
+	}
+	return 0
+}
+
+func (x *IERCTransaction_Staking) GetRecords() []*IERCTransaction_StakingRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+var File_indexer_indexer_proto protoreflect.FileDescriptor
+
+var file_indexer_indexer_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x30, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65,
+	0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x1c,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a,
+	0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0xcd, 0x02, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x08,
+	0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0d,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x74, 0x6f, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x54, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x95, 0x02, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x62, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0d, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x8a, 0x01,
+	0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70,
+	0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x51, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x88, 0x01,
+	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x41, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x6f, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x69, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x73, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e,
+	0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x69, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x9d, 0x02, 0x0a,
+	0x0d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e,
+	0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x75, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x70, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x95, 0x07, 0x0a, 0x04, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x06, 0x69, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x2e, 0x49, 0x45, 0x52,
+	0x43, 0x32, 0x30, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x69, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x65, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x77, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x50, 0x6f, 0x57,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x69, 0x65, 0x72, 0x63, 0x50, 0x6f,
+	0x77, 0x1a, 0x5d, 0x0a, 0x0c, 0x49, 0x45, 0x52, 0x43, 0x32, 0x30, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f,
+	0x72, 0x6b, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x6b, 0x63,
+	0x1a, 0xe0, 0x03, 0x0a, 0x0d, 0x49, 0x45, 0x52, 0x43, 0x50, 0x6f, 0x57, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x5f, 0x0a, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52,
+	0x43, 0x50, 0x6f, 0x57, 0x54, 0x69, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x11, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x49, 0x45, 0x52, 0x43, 0x50, 0x6f, 0x57, 0x54, 0x69, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6f, 0x77, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x6f, 0x77, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x6f, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x6f, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70,
+	0x6f, 0x77, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x2a,
+	0x0a, 0x11, 0x70, 0x6f, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x52, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x08, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x24, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x63, 0x6b, 0x22, 0x35, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x54, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x72, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x5a,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x69,
+	0x63, 0x6b, 0x52, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xd0, 0x03, 0x0a, 0x0b, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x75, 0x62, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x46, 0x0a, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0b, 0x74, 0x69, 0x63,
+	0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x94, 0x01, 0x0a, 0x0a, 0x54, 0x69, 0x63, 0x6b, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x86, 0x04,
+	0x0a, 0x0f, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x75,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c,
+	0x53, 0x75, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x4a, 0x0a,
+	0x0c, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0b, 0x74, 0x69,
+	0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x62, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x62, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x4e, 0x0a, 0x0a, 0x54, 0x69, 0x63, 0x6b, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x68, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x05,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x4b, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x75, 0x62, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x22,
+	0x75, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x78, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x85, 0x03, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12,
+	0x24, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x22, 0x41, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x66, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xdf, 0x03, 0x0a, 0x09, 0x54,
+	0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69,
+	0x6e, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x29, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x63, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x69,
+	0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0xe6, 0x18, 0x0a, 0x0f, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12,
+	0x3d, 0x0a, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45,
+	0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x37,
+	0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x5f, 0x70, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x50, 0x6f, 0x57, 0x48, 0x00, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x50, 0x6f, 0x77,
+	0x12, 0x41, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x57, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x74,
+	0x50, 0x6f, 0x77, 0x12, 0x43, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x53, 0x65, 0x6c, 0x6c, 0x12, 0x50, 0x0a, 0x0d, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x53, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52,
+	0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x50, 0x0a, 0x0d, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x48, 0x00, 0x52,
+	0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x1a, 0xbc, 0x01,
+	0x0a, 0x06, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x6b, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x77, 0x6f, 0x72, 0x6b, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x1a, 0x48, 0x0a, 0x04,
+	0x4d, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x1a, 0xf5, 0x01, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x50, 0x6f, 0x57, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x12, 0x5f, 0x0a, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
+	0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45,
+	0x52, 0x43, 0x50, 0x6f, 0x57, 0x54, 0x69, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x11, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x49, 0x45, 0x52, 0x43, 0x50, 0x6f, 0x57, 0x54, 0x69, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x1a, 0x61,
+	0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x57, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x1a, 0x60, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a, 0x0c, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61,
+	0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67,
+	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x6b, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69,
+	0x67, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x1a, 0xb2, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x1a, 0xee, 0x01, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x75, 0x62, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4a,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x87, 0x01, 0x0a, 0x0d, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70,
+	0x6f, 0x6f, 0x6c, 0x53, 0x75, 0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3b, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x51, 0x0a,
+	0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x07, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x1a, 0x51, 0x0a, 0x0a, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x43,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45,
+	0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x1a, 0x55, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53,
+	0x65, 0x6c, 0x6c, 0x12, 0x45, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x5b, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x6f,
+	0x6f, 0x6c, 0x53, 0x75, 0x62, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x09, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x8b, 0x03, 0x0a, 0x0b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x47, 0x0a,
+	0x10, 0x69, 0x65, 0x72, 0x63, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x69, 0x65, 0x72, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0x7d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xcd, 0x01, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x5c, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x45, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xbd, 0x15, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x30, 0x01, 0x12, 0x6d, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x30,
+	0x01, 0x12, 0x6b, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x7d,
+	0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32,
+	0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x79, 0x0a,
+	0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x7e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x72, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41,
+	0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x2f, 0x61, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x5d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x74, 0x69, 0x63, 0x6b,
+	0x12, 0x64, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x2f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x7a, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x91, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x70, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x77, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x74, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12,
+	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x7f, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x79, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x76, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32,
+	0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x70, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x73, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x42, 0x44, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x49, 0x45, 0x72, 0x63, 0x4f, 0x72, 0x67, 0x2f, 0x49, 0x45, 0x52, 0x43,
+	0x5f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_indexer_indexer_proto_rawDescOnce sync.Once
+	file_indexer_indexer_proto_rawDescData = file_indexer_indexer_proto_rawDesc
+)
+
+func file_indexer_indexer_proto_rawDescGZIP() []byte {
+	file_indexer_indexer_proto_rawDescOnce.Do(func() {
+		file_indexer_indexer_proto_rawDescData = protoimpl.X.CompressGZIP(file_indexer_indexer_proto_rawDescData)
+	})
+	return file_indexer_indexer_proto_rawDescData
+}
+
+var file_indexer_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_indexer_indexer_proto_goTypes = []interface{}{
+	(*SubscribeRequest)(nil),                    // 0: api.indexer.SubscribeRequest
+	(*SubscribeReply)(nil),                      // 1: api.indexer.SubscribeReply
+	(*SubscribeSystemStatusRequest)(nil),        // 2: api.indexer.SubscribeSystemStatusRequest
+	(*SubscribeSystemStatusReply)(nil),          // 3: api.indexer.SubscribeSystemStatusReply
+	(*QueryEventsRequest)(nil),                  // 4: api.indexer.QueryEventsRequest
+	(*QueryEventsReply)(nil),                    // 5: api.indexer.QueryEventsReply
+	(*QuerySystemStatusRequest)(nil),            // 6: api.indexer.QuerySystemStatusRequest
+	(*QuerySystemStatusReply)(nil),              // 7: api.indexer.QuerySystemStatusReply
+	(*CheckTransferRequest)(nil),                // 8: api.indexer.CheckTransferRequest
+	(*CheckTransferReply)(nil),                  // 9: api.indexer.CheckTransferReply
+	(*Balance)(nil),                             // 10: api.indexer.Balance
+	(*GetBalanceRequest)(nil),                   // 11: api.indexer.GetBalanceRequest
+	(*GetBalanceReply)(nil),                     // 12: api.indexer.GetBalanceReply
+	(*ListBalancesByAddressRequest)(nil),        // 13: api.indexer.ListBalancesByAddressRequest
+	(*ListBalancesByAddressReply)(nil),          // 14: api.indexer.ListBalancesByAddressReply
+	(*ListHoldersByTickRequest)(nil),            // 15: api.indexer.ListHoldersByTickRequest
+	(*ListHoldersByTickReply)(nil),              // 16: api.indexer.ListHoldersByTickReply
+	(*GetBalanceAtRequest)(nil),                 // 17: api.indexer.GetBalanceAtRequest
+	(*GetBalanceAtReply)(nil),                   // 18: api.indexer.GetBalanceAtReply
+	(*BalanceChange)(nil),                       // 19: api.indexer.BalanceChange
+	(*ListBalanceChangesRequest)(nil),           // 20: api.indexer.ListBalanceChangesRequest
+	(*ListBalanceChangesReply)(nil),             // 21: api.indexer.ListBalanceChangesReply
+	(*Tick)(nil),                                // 22: api.indexer.Tick
+	(*GetTickRequest)(nil),                      // 23: api.indexer.GetTickRequest
+	(*GetTickReply)(nil),                        // 24: api.indexer.GetTickReply
+	(*ListTicksRequest)(nil),                    // 25: api.indexer.ListTicksRequest
+	(*ListTicksReply)(nil),                      // 26: api.indexer.ListTicksReply
+	(*StakingPool)(nil),                         // 27: api.indexer.StakingPool
+	(*StakingPosition)(nil),                     // 28: api.indexer.StakingPosition
+	(*ListStakingPoolsRequest)(nil),             // 29: api.indexer.ListStakingPoolsRequest
+	(*ListStakingPoolsReply)(nil),               // 30: api.indexer.ListStakingPoolsReply
+	(*GetStakingPoolRequest)(nil),               // 31: api.indexer.GetStakingPoolRequest
+	(*GetStakingPoolReply)(nil),                 // 32: api.indexer.GetStakingPoolReply
+	(*ListStakingPositionsRequest)(nil),         // 33: api.indexer.ListStakingPositionsRequest
+	(*ListStakingPositionsReply)(nil),           // 34: api.indexer.ListStakingPositionsReply
+	(*Listing)(nil),                             // 35: api.indexer.Listing
+	(*GetListingRequest)(nil),                   // 36: api.indexer.GetListingRequest
+	(*GetListingReply)(nil),                     // 37: api.indexer.GetListingReply
+	(*ListListingsRequest)(nil),                 // 38: api.indexer.ListListingsRequest
+	(*ListListingsReply)(nil),                   // 39: api.indexer.ListListingsReply
+	(*TickStats)(nil),                           // 40: api.indexer.TickStats
+	(*GetTickStatsRequest)(nil),                 // 41: api.indexer.GetTickStatsRequest
+	(*GetTickStatsReply)(nil),                   // 42: api.indexer.GetTickStatsReply
+	(*ListTickStatsRequest)(nil),                // 43: api.indexer.ListTickStatsRequest
+	(*ListTickStatsReply)(nil),                  // 44: api.indexer.ListTickStatsReply
+	(*IERCTransaction)(nil),                     // 45: api.indexer.IERCTransaction
+	(*Transaction)(nil),                         // 46: api.indexer.Transaction
+	(*GetTransactionRequest)(nil),               // 47: api.indexer.GetTransactionRequest
+	(*GetTransactionReply)(nil),                 // 48: api.indexer.GetTransactionReply
+	(*Webhook)(nil),                             // 49: api.indexer.Webhook
+	(*CreateWebhookRequest)(nil),                // 50: api.indexer.CreateWebhookRequest
+	(*CreateWebhookReply)(nil),                  // 51: api.indexer.CreateWebhookReply
+	(*ListWebhooksRequest)(nil),                 // 52: api.indexer.ListWebhooksRequest
+	(*ListWebhooksReply)(nil),                   // 53: api.indexer.ListWebhooksReply
+	(*DeleteWebhookRequest)(nil),                // 54: api.indexer.DeleteWebhookRequest
+	(*DeleteWebhookReply)(nil),                  // 55: api.indexer.DeleteWebhookReply
+	(*QueryEventsReply_EventsByBlock)(nil),      // 56: api.indexer.QueryEventsReply.EventsByBlock
+	(*CheckTransferReply_TransferRecord)(nil),   // 57: api.indexer.CheckTransferReply.TransferRecord
+	(*Tick_IERC20Detail)(nil),                   // 58: api.indexer.Tick.IERC20Detail
+	(*Tick_IERCPoWDetail)(nil),                  // 59: api.indexer.Tick.IERCPoWDetail
+	(*StakingPool_TickDetail)(nil),              // 60: api.indexer.StakingPool.TickDetail
+	(*StakingPosition_TickDetail)(nil),          // 61: api.indexer.StakingPosition.TickDetail
+	(*IERCTransaction_Deploy)(nil),              // 62: api.indexer.IERCTransaction.Deploy
+	(*IERCTransaction_Mint)(nil),                // 63: api.indexer.IERCTransaction.Mint
+	(*IERCTransaction_DeployPoW)(nil),           // 64: api.indexer.IERCTransaction.DeployPoW
+	(*IERCTransaction_MintPoW)(nil),             // 65: api.indexer.IERCTransaction.MintPoW
+	(*IERCTransaction_TransferRecord)(nil),      // 66: api.indexer.IERCTransaction.TransferRecord
+	(*IERCTransaction_FreezeRecord)(nil),        // 67: api.indexer.IERCTransaction.FreezeRecord
+	(*IERCTransaction_UnfreezeRecord)(nil),      // 68: api.indexer.IERCTransaction.UnfreezeRecord
+	(*IERCTransaction_ProxyTransferRecord)(nil), // 69: api.indexer.IERCTransaction.ProxyTransferRecord
+	(*IERCTransaction_ConfigStake)(nil),         // 70: api.indexer.IERCTransaction.ConfigStake
+	(*IERCTransaction_StakingRecord)(nil),       // 71: api.indexer.IERCTransaction.StakingRecord
+	(*IERCTransaction_Modify)(nil),              // 72: api.indexer.IERCTransaction.Modify
+	(*IERCTransaction_ClaimAirdrop)(nil),        // 73: api.indexer.IERCTransaction.ClaimAirdrop
+	(*IERCTransaction_Transfer)(nil),            // 74: api.indexer.IERCTransaction.Transfer
+	(*IERCTransaction_FreezeSell)(nil),          // 75: api.indexer.IERCTransaction.FreezeSell
+	(*IERCTransaction_UnfreezeSell)(nil),        // 76: api.indexer.IERCTransaction.UnfreezeSell
+	(*IERCTransaction_ProxyTransfer)(nil),       // 77: api.indexer.IERCTransaction.ProxyTransfer
+	(*IERCTransaction_Staking)(nil),             // 78: api.indexer.IERCTransaction.Staking
+	(Operate)(0),                                // 79: api.indexer.Operate
+	(*Event)(nil),                               // 80: api.indexer.Event
+	(*IERCPoWTickCreated_TokenomicsDetail)(nil), // 81: api.indexer.IERCPoWTickCreated.TokenomicsDetail
+	(*IERCPoWTickCreated_Rule)(nil),             // 82: api.indexer.IERCPoWTickCreated.Rule
+	(*StakingPoolUpdated_TickConfigDetail)(nil), // 83: api.indexer.StakingPoolUpdated.TickConfigDetail
+}
+var file_indexer_indexer_proto_depIdxs = []int32{
+	79, // 0: api.indexer.SubscribeRequest.operates:type_name -> api.indexer.Operate
+	80, // 1: api.indexer.SubscribeReply.events:type_name -> api.indexer.Event
+	79, // 2: api.indexer.QueryEventsRequest.operate:type_name -> api.indexer.Operate
+	56, // 3: api.indexer.QueryEventsReply.event_by_blocks:type_name -> api.indexer.QueryEventsReply.EventsByBlock
+	57, // 4: api.indexer.CheckTransferReply.data:type_name -> api.indexer.CheckTransferReply.TransferRecord
+	10, // 5: api.indexer.GetBalanceReply.balance:type_name -> api.indexer.Balance
+	10, // 6: api.indexer.ListBalancesByAddressReply.balances:type_name -> api.indexer.Balance
+	10, // 7: api.indexer.ListHoldersByTickReply.holders:type_name -> api.indexer.Balance
+	10, // 8: api.indexer.GetBalanceAtReply.balance:type_name -> api.indexer.Balance
+	19, // 9: api.indexer.ListBalanceChangesReply.changes:type_name -> api.indexer.BalanceChange
+	58, // 10: api.indexer.Tick.ierc20:type_name -> api.indexer.Tick.IERC20Detail
+	59, // 11: api.indexer.Tick.ierc_pow:type_name -> api.indexer.Tick.IERCPoWDetail
+	22, // 12: api.indexer.GetTickReply.tick:type_name -> api.indexer.Tick
+	22, // 13: api.indexer.ListTicksReply.ticks:type_name -> api.indexer.Tick
+	60, // 14: api.indexer.StakingPool.tick_details:type_name -> api.indexer.StakingPool.TickDetail
+	61, // 15: api.indexer.StakingPosition.tick_details:type_name -> api.indexer.StakingPosition.TickDetail
+	27, // 16: api.indexer.ListStakingPoolsReply.pools:type_name -> api.indexer.StakingPool
+	27, // 17: api.indexer.GetStakingPoolReply.pool:type_name -> api.indexer.StakingPool
+	28, // 18: api.indexer.ListStakingPositionsReply.positions:type_name -> api.indexer.StakingPosition
+	35, // 19: api.indexer.GetListingReply.listing:type_name -> api.indexer.Listing
+	35, // 20: api.indexer.ListListingsReply.listings:type_name -> api.indexer.Listing
+	40, // 21: api.indexer.GetTickStatsReply.stats:type_name -> api.indexer.TickStats
+	40, // 22: api.indexer.ListTickStatsReply.stats:type_name -> api.indexer.TickStats
+	79, // 23: api.indexer.IERCTransaction.operate:type_name -> api.indexer.Operate
+	62, // 24: api.indexer.IERCTransaction.deploy:type_name -> api.indexer.IERCTransaction.Deploy
+	63, // 25: api.indexer.IERCTransaction.mint:type_name -> api.indexer.IERCTransaction.Mint
+	64, // 26: api.indexer.IERCTransaction.deploy_pow:type_name -> api.indexer.IERCTransaction.DeployPoW
+	65, // 27: api.indexer.IERCTransaction.mint_pow:type_name -> api.indexer.IERCTransaction.MintPoW
+	74, // 28: api.indexer.IERCTransaction.transfer:type_name -> api.indexer.IERCTransaction.Transfer
+	75, // 29: api.indexer.IERCTransaction.freeze_sell:type_name -> api.indexer.IERCTransaction.FreezeSell
+	76, // 30: api.indexer.IERCTransaction.unfreeze_sell:type_name -> api.indexer.IERCTransaction.UnfreezeSell
+	77, // 31: api.indexer.IERCTransaction.proxy_transfer:type_name -> api.indexer.IERCTransaction.ProxyTransfer
+	70, // 32: api.indexer.IERCTransaction.config_stake:type_name -> api.indexer.IERCTransaction.ConfigStake
+	78, // 33: api.indexer.IERCTransaction.staking:type_name -> api.indexer.IERCTransaction.Staking
+	72, // 34: api.indexer.IERCTransaction.modify:type_name -> api.indexer.IERCTransaction.Modify
+	73, // 35: api.indexer.IERCTransaction.claim_airdrop:type_name -> api.indexer.IERCTransaction.ClaimAirdrop
+	45, // 36: api.indexer.Transaction.ierc_transaction:type_name -> api.indexer.IERCTransaction
+	46, // 37: api.indexer.GetTransactionReply.transaction:type_name -> api.indexer.Transaction
+	80, // 38: api.indexer.GetTransactionReply.events:type_name -> api.indexer.Event
+	79, // 39: api.indexer.Webhook.operates:type_name -> api.indexer.Operate
+	79, // 40: api.indexer.CreateWebhookRequest.operates:type_name -> api.indexer.Operate
+	49, // 41: api.indexer.CreateWebhookReply.webhook:type_name -> api.indexer.Webhook
+	49, // 42: api.indexer.ListWebhooksReply.webhooks:type_name -> api.indexer.Webhook
+	80, // 43: api.indexer.QueryEventsReply.EventsByBlock.events:type_name -> api.indexer.Event
+	81, // 44: api.indexer.Tick.IERCPoWDetail.tokenomics_details:type_name -> api.indexer.IERCPoWTickCreated.TokenomicsDetail
+	82, // 45: api.indexer.Tick.IERCPoWDetail.rule:type_name -> api.indexer.IERCPoWTickCreated.Rule
+	81, // 46: api.indexer.IERCTransaction.DeployPoW.tokenomics_details:type_name -> api.indexer.IERCPoWTickCreated.TokenomicsDetail
+	82, // 47: api.indexer.IERCTransaction.DeployPoW.rule:type_name -> api.indexer.IERCPoWTickCreated.Rule
+	83, // 48: api.indexer.IERCTransaction.ConfigStake.details:type_name -> api.indexer.StakingPoolUpdated.TickConfigDetail
+	66, // 49: api.indexer.IERCTransaction.Transfer.records:type_name -> api.indexer.IERCTransaction.TransferRecord
+	67, // 50: api.indexer.IERCTransaction.FreezeSell.records:type_name -> api.indexer.IERCTransaction.FreezeRecord
+	68, // 51: api.indexer.IERCTransaction.UnfreezeSell.records:type_name -> api.indexer.IERCTransaction.UnfreezeRecord
+	69, // 52: api.indexer.IERCTransaction.ProxyTransfer.records:type_name -> api.indexer.IERCTransaction.ProxyTransferRecord
+	71, // 53: api.indexer.IERCTransaction.Staking.records:type_name -> api.indexer.IERCTransaction.StakingRecord
+	0,  // 54: api.indexer.Indexer.SubscribeEvent:input_type -> api.indexer.SubscribeRequest
+	2,  // 55: api.indexer.Indexer.SubscribeSystemStatus:input_type -> api.indexer.SubscribeSystemStatusRequest
+	4,  // 56: api.indexer.Indexer.QueryEvents:input_type -> api.indexer.QueryEventsRequest
+	6,  // 57: api.indexer.Indexer.QuerySystemStatus:input_type -> api.indexer.QuerySystemStatusRequest
+	8,  // 58: api.indexer.Indexer.CheckTransfer:input_type -> api.indexer.CheckTransferRequest
+	11, // 59: api.indexer.Indexer.GetBalance:input_type -> api.indexer.GetBalanceRequest
+	13, // 60: api.indexer.Indexer.ListBalancesByAddress:input_type -> api.indexer.ListBalancesByAddressRequest
+	15, // 61: api.indexer.Indexer.ListHoldersByTick:input_type -> api.indexer.ListHoldersByTickRequest
+	17, // 62: api.indexer.Indexer.GetBalanceAt:input_type -> api.indexer.GetBalanceAtRequest
+	20, // 63: api.indexer.Indexer.ListBalanceChanges:input_type -> api.indexer.ListBalanceChangesRequest
+	23, // 64: api.indexer.Indexer.GetTick:input_type -> api.indexer.GetTickRequest
+	25, // 65: api.indexer.Indexer.ListTicks:input_type -> api.indexer.ListTicksRequest
+	29, // 66: api.indexer.Indexer.ListStakingPools:input_type -> api.indexer.ListStakingPoolsRequest
+	31, // 67: api.indexer.Indexer.GetStakingPool:input_type -> api.indexer.GetStakingPoolRequest
+	33, // 68: api.indexer.Indexer.ListStakingPositions:input_type -> api.indexer.ListStakingPositionsRequest
+	36, // 69: api.indexer.Indexer.GetListing:input_type -> api.indexer.GetListingRequest
+	38, // 70: api.indexer.Indexer.ListListings:input_type -> api.indexer.ListListingsRequest
+	41, // 71: api.indexer.Indexer.GetTickStats:input_type -> api.indexer.GetTickStatsRequest
+	43, // 72: api.indexer.Indexer.ListTickStats:input_type -> api.indexer.ListTickStatsRequest
+	47, // 73: api.indexer.Indexer.GetTransaction:input_type -> api.indexer.GetTransactionRequest
+	50, // 74: api.indexer.Indexer.CreateWebhook:input_type -> api.indexer.CreateWebhookRequest
+	52, // 75: api.indexer.Indexer.ListWebhooks:input_type -> api.indexer.ListWebhooksRequest
+	54, // 76: api.indexer.Indexer.DeleteWebhook:input_type -> api.indexer.DeleteWebhookRequest
+	1,  // 77: api.indexer.Indexer.SubscribeEvent:output_type -> api.indexer.SubscribeReply
+	3,  // 78: api.indexer.Indexer.SubscribeSystemStatus:output_type -> api.indexer.SubscribeSystemStatusReply
+	5,  // 79: api.indexer.Indexer.QueryEvents:output_type -> api.indexer.QueryEventsReply
+	7,  // 80: api.indexer.Indexer.QuerySystemStatus:output_type -> api.indexer.QuerySystemStatusReply
+	9,  // 81: api.indexer.Indexer.CheckTransfer:output_type -> api.indexer.CheckTransferReply
+	12, // 82: api.indexer.Indexer.GetBalance:output_type -> api.indexer.GetBalanceReply
+	14, // 83: api.indexer.Indexer.ListBalancesByAddress:output_type -> api.indexer.ListBalancesByAddressReply
+	16, // 84: api.indexer.Indexer.ListHoldersByTick:output_type -> api.indexer.ListHoldersByTickReply
+	18, // 85: api.indexer.Indexer.GetBalanceAt:output_type -> api.indexer.GetBalanceAtReply
+	21, // 86: api.indexer.Indexer.ListBalanceChanges:output_type -> api.indexer.ListBalanceChangesReply
+	24, // 87: api.indexer.Indexer.GetTick:output_type -> api.indexer.GetTickReply
+	26, // 88: api.indexer.Indexer.ListTicks:output_type -> api.indexer.ListTicksReply
+	30, // 89: api.indexer.Indexer.ListStakingPools:output_type -> api.indexer.ListStakingPoolsReply
+	32, // 90: api.indexer.Indexer.GetStakingPool:output_type -> api.indexer.GetStakingPoolReply
+	34, // 91: api.indexer.Indexer.ListStakingPositions:output_type -> api.indexer.ListStakingPositionsReply
+	37, // 92: api.indexer.Indexer.GetListing:output_type -> api.indexer.GetListingReply
+	39, // 93: api.indexer.Indexer.ListListings:output_type -> api.indexer.ListListingsReply
+	42, // 94: api.indexer.Indexer.GetTickStats:output_type -> api.indexer.GetTickStatsReply
+	44, // 95: api.indexer.Indexer.ListTickStats:output_type -> api.indexer.ListTickStatsReply
+	48, // 96: api.indexer.Indexer.GetTransaction:output_type -> api.indexer.GetTransactionReply
+	51, // 97: api.indexer.Indexer.CreateWebhook:output_type -> api.indexer.CreateWebhookReply
+	53, // 98: api.indexer.Indexer.ListWebhooks:output_type -> api.indexer.ListWebhooksReply
+	55, // 99: api.indexer.Indexer.DeleteWebhook:output_type -> api.indexer.DeleteWebhookReply
+	77, // [77:100] is the sub-list for method output_type
+	54, // [54:77] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
+}
+
+func init() { file_indexer_indexer_proto_init() }
+func file_indexer_indexer_proto_init() {
+	if File_indexer_indexer_proto != nil {
+		return
+	}
+	file_indexer_event_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_indexer_indexer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSystemStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSystemStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySystemStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySystemStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckTransferReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Balance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesByAddressReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHoldersByTickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHoldersByTickReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceAtReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalanceChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalanceChangesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTicksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTicksReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStakingPoolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStakingPoolsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStakingPoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStakingPoolReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStakingPositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStakingPositionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Listing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListingReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListListingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListListingsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickStatsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTickStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTickStatsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -845,8 +7478,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeReply); i {
+		file_indexer_indexer_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -857,8 +7490,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSystemStatusRequest); i {
+		file_indexer_indexer_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -869,8 +7502,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSystemStatusReply); i {
+		file_indexer_indexer_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -881,8 +7514,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEventsRequest); i {
+		file_indexer_indexer_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -893,8 +7526,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEventsReply); i {
+		file_indexer_indexer_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -905,8 +7538,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySystemStatusRequest); i {
+		file_indexer_indexer_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -917,8 +7550,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySystemStatusReply); i {
+		file_indexer_indexer_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -929,8 +7562,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckTransferRequest); i {
+		file_indexer_indexer_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -941,8 +7574,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckTransferReply); i {
+		file_indexer_indexer_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -953,7 +7586,31 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryEventsReply_EventsByBlock); i {
 			case 0:
 				return &v.state
@@ -965,7 +7622,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckTransferReply_TransferRecord); i {
 			case 0:
 				return &v.state
@@ -977,6 +7634,278 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
+		file_indexer_indexer_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tick_IERC20Detail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tick_IERCPoWDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingPool_TickDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingPosition_TickDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_Deploy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_Mint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_DeployPoW); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_MintPoW); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_TransferRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_FreezeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_UnfreezeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_ProxyTransferRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_ConfigStake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_StakingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_Modify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_ClaimAirdrop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_Transfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_FreezeSell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_UnfreezeSell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_ProxyTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCTransaction_Staking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_indexer_indexer_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_indexer_indexer_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_indexer_indexer_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*Tick_Ierc20)(nil),
+		(*Tick_IercPow)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*IERCTransaction_Deploy_)(nil),
+		(*IERCTransaction_Mint_)(nil),
+		(*IERCTransaction_DeployPow)(nil),
+		(*IERCTransaction_MintPow)(nil),
+		(*IERCTransaction_Transfer_)(nil),
+		(*IERCTransaction_FreezeSell_)(nil),
+		(*IERCTransaction_UnfreezeSell_)(nil),
+		(*IERCTransaction_ProxyTransfer_)(nil),
+		(*IERCTransaction_ConfigStake_)(nil),
+		(*IERCTransaction_Staking_)(nil),
+		(*IERCTransaction_Modify_)(nil),
+		(*IERCTransaction_ClaimAirdrop_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -984,7 +7913,7 @@ func file_indexer_indexer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_indexer_indexer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
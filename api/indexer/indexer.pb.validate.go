@@ -59,6 +59,12 @@ func (m *SubscribeRequest) validate(all bool) error {
 
 	// no validation rules for StartBlock
 
+	// no validation rules for Cursor
+
+	if m.IncludeFailed != nil {
+		// no validation rules for IncludeFailed
+	}
+
 	if len(errors) > 0 {
 		return SubscribeRequestMultiError(errors)
 	}
@@ -197,6 +203,10 @@ func (m *SubscribeReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Rollback
+
+	// no validation rules for Cursor
+
 	if len(errors) > 0 {
 		return SubscribeReplyMultiError(errors)
 	}
@@ -512,6 +522,24 @@ func (m *QueryEventsRequest) validate(all bool) error {
 
 	// no validation rules for Size
 
+	// no validation rules for Tick
+
+	// no validation rules for Address
+
+	// no validation rules for Operate
+
+	// no validation rules for EventAtFrom
+
+	// no validation rules for EventAtTo
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	if m.ErrCode != nil {
+		// no validation rules for ErrCode
+	}
+
 	if len(errors) > 0 {
 		return QueryEventsRequestMultiError(errors)
 	}
@@ -648,6 +676,8 @@ func (m *QueryEventsReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return QueryEventsReplyMultiError(errors)
 	}
@@ -1169,80 +1199,54 @@ var _ interface {
 	ErrorName() string
 } = CheckTransferReplyValidationError{}
 
-// Validate checks the field values on QueryEventsReply_EventsByBlock with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryEventsReply_EventsByBlock) Validate() error {
+// Validate checks the field values on Balance with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Balance) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryEventsReply_EventsByBlock with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// QueryEventsReply_EventsByBlockMultiError, or nil if none found.
-func (m *QueryEventsReply_EventsByBlock) ValidateAll() error {
+// ValidateAll checks the field values on Balance with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BalanceMultiError, or nil if none found.
+func (m *Balance) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryEventsReply_EventsByBlock) validate(all bool) error {
+func (m *Balance) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BlockNumber
+	// no validation rules for Address
 
-	// no validation rules for PrevBlockNumber
+	// no validation rules for Tick
 
-	for idx, item := range m.GetEvents() {
-		_, _ = idx, item
+	// no validation rules for Available
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QueryEventsReply_EventsByBlockValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, QueryEventsReply_EventsByBlockValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return QueryEventsReply_EventsByBlockValidationError{
-					field:  fmt.Sprintf("Events[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Freeze
 
-	}
+	// no validation rules for Minted
+
+	// no validation rules for Total
+
+	// no validation rules for LastUpdatedBlock
 
 	if len(errors) > 0 {
-		return QueryEventsReply_EventsByBlockMultiError(errors)
+		return BalanceMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryEventsReply_EventsByBlockMultiError is an error wrapping multiple
-// validation errors returned by QueryEventsReply_EventsByBlock.ValidateAll()
-// if the designated constraints aren't met.
-type QueryEventsReply_EventsByBlockMultiError []error
+// BalanceMultiError is an error wrapping multiple validation errors returned
+// by Balance.ValidateAll() if the designated constraints aren't met.
+type BalanceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryEventsReply_EventsByBlockMultiError) Error() string {
+func (m BalanceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1251,12 +1255,11 @@ func (m QueryEventsReply_EventsByBlockMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryEventsReply_EventsByBlockMultiError) AllErrors() []error { return m }
+func (m BalanceMultiError) AllErrors() []error { return m }
 
-// QueryEventsReply_EventsByBlockValidationError is the validation error
-// returned by QueryEventsReply_EventsByBlock.Validate if the designated
-// constraints aren't met.
-type QueryEventsReply_EventsByBlockValidationError struct {
+// BalanceValidationError is the validation error returned by Balance.Validate
+// if the designated constraints aren't met.
+type BalanceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1264,24 +1267,22 @@ type QueryEventsReply_EventsByBlockValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryEventsReply_EventsByBlockValidationError) Field() string { return e.field }
+func (e BalanceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryEventsReply_EventsByBlockValidationError) Reason() string { return e.reason }
+func (e BalanceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryEventsReply_EventsByBlockValidationError) Cause() error { return e.cause }
+func (e BalanceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryEventsReply_EventsByBlockValidationError) Key() bool { return e.key }
+func (e BalanceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryEventsReply_EventsByBlockValidationError) ErrorName() string {
-	return "QueryEventsReply_EventsByBlockValidationError"
-}
+func (e BalanceValidationError) ErrorName() string { return "BalanceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QueryEventsReply_EventsByBlockValidationError) Error() string {
+func (e BalanceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1293,14 +1294,14 @@ func (e QueryEventsReply_EventsByBlockValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryEventsReply_EventsByBlock.%s: %s%s",
+		"invalid %sBalance.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryEventsReply_EventsByBlockValidationError{}
+var _ error = BalanceValidationError{}
 
 var _ interface {
 	Field() string
@@ -1308,56 +1309,179 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryEventsReply_EventsByBlockValidationError{}
+} = BalanceValidationError{}
 
-// Validate checks the field values on CheckTransferReply_TransferRecord with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckTransferReply_TransferRecord) Validate() error {
+// Validate checks the field values on GetBalanceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckTransferReply_TransferRecord
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckTransferReply_TransferRecordMultiError, or nil if none found.
-func (m *CheckTransferReply_TransferRecord) ValidateAll() error {
+// ValidateAll checks the field values on GetBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceRequestMultiError, or nil if none found.
+func (m *GetBalanceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckTransferReply_TransferRecord) validate(all bool) error {
+func (m *GetBalanceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Sender
-
-	// no validation rules for Receiver
+	// no validation rules for Address
 
 	// no validation rules for Tick
 
-	// no validation rules for Amount
+	if len(errors) > 0 {
+		return GetBalanceRequestMultiError(errors)
+	}
 
-	// no validation rules for Status
+	return nil
+}
+
+// GetBalanceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBalanceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBalanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBalanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBalanceRequestMultiError) AllErrors() []error { return m }
+
+// GetBalanceRequestValidationError is the validation error returned by
+// GetBalanceRequest.Validate if the designated constraints aren't met.
+type GetBalanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBalanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBalanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBalanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBalanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBalanceRequestValidationError) ErrorName() string {
+	return "GetBalanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBalanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBalanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBalanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBalanceRequestValidationError{}
+
+// Validate checks the field values on GetBalanceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBalanceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceReplyMultiError, or nil if none found.
+func (m *GetBalanceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBalanceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBalance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBalanceReplyValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBalanceReplyValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBalance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBalanceReplyValidationError{
+				field:  "Balance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CheckTransferReply_TransferRecordMultiError(errors)
+		return GetBalanceReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckTransferReply_TransferRecordMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckTransferReply_TransferRecord.ValidateAll() if the designated
-// constraints aren't met.
-type CheckTransferReply_TransferRecordMultiError []error
+// GetBalanceReplyMultiError is an error wrapping multiple validation errors
+// returned by GetBalanceReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetBalanceReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckTransferReply_TransferRecordMultiError) Error() string {
+func (m GetBalanceReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1366,12 +1490,11 @@ func (m CheckTransferReply_TransferRecordMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckTransferReply_TransferRecordMultiError) AllErrors() []error { return m }
+func (m GetBalanceReplyMultiError) AllErrors() []error { return m }
 
-// CheckTransferReply_TransferRecordValidationError is the validation error
-// returned by CheckTransferReply_TransferRecord.Validate if the designated
-// constraints aren't met.
-type CheckTransferReply_TransferRecordValidationError struct {
+// GetBalanceReplyValidationError is the validation error returned by
+// GetBalanceReply.Validate if the designated constraints aren't met.
+type GetBalanceReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1379,24 +1502,22 @@ type CheckTransferReply_TransferRecordValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckTransferReply_TransferRecordValidationError) Field() string { return e.field }
+func (e GetBalanceReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckTransferReply_TransferRecordValidationError) Reason() string { return e.reason }
+func (e GetBalanceReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckTransferReply_TransferRecordValidationError) Cause() error { return e.cause }
+func (e GetBalanceReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckTransferReply_TransferRecordValidationError) Key() bool { return e.key }
+func (e GetBalanceReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckTransferReply_TransferRecordValidationError) ErrorName() string {
-	return "CheckTransferReply_TransferRecordValidationError"
-}
+func (e GetBalanceReplyValidationError) ErrorName() string { return "GetBalanceReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CheckTransferReply_TransferRecordValidationError) Error() string {
+func (e GetBalanceReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1408,14 +1529,14 @@ func (e CheckTransferReply_TransferRecordValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckTransferReply_TransferRecord.%s: %s%s",
+		"invalid %sGetBalanceReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckTransferReply_TransferRecordValidationError{}
+var _ error = GetBalanceReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1423,4 +1544,8739 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckTransferReply_TransferRecordValidationError{}
+} = GetBalanceReplyValidationError{}
+
+// Validate checks the field values on ListBalancesByAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalancesByAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalancesByAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalancesByAddressRequestMultiError, or nil if none found.
+func (m *ListBalancesByAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalancesByAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	// no validation rules for OrderByTotal
+
+	if len(errors) > 0 {
+		return ListBalancesByAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalancesByAddressRequestMultiError is an error wrapping multiple
+// validation errors returned by ListBalancesByAddressRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListBalancesByAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalancesByAddressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalancesByAddressRequestMultiError) AllErrors() []error { return m }
+
+// ListBalancesByAddressRequestValidationError is the validation error returned
+// by ListBalancesByAddressRequest.Validate if the designated constraints
+// aren't met.
+type ListBalancesByAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalancesByAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalancesByAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalancesByAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalancesByAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalancesByAddressRequestValidationError) ErrorName() string {
+	return "ListBalancesByAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalancesByAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalancesByAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalancesByAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalancesByAddressRequestValidationError{}
+
+// Validate checks the field values on ListBalancesByAddressReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalancesByAddressReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalancesByAddressReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalancesByAddressReplyMultiError, or nil if none found.
+func (m *ListBalancesByAddressReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalancesByAddressReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBalances() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBalancesByAddressReplyValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBalancesByAddressReplyValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBalancesByAddressReplyValidationError{
+					field:  fmt.Sprintf("Balances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListBalancesByAddressReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalancesByAddressReplyMultiError is an error wrapping multiple
+// validation errors returned by ListBalancesByAddressReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListBalancesByAddressReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalancesByAddressReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalancesByAddressReplyMultiError) AllErrors() []error { return m }
+
+// ListBalancesByAddressReplyValidationError is the validation error returned
+// by ListBalancesByAddressReply.Validate if the designated constraints aren't met.
+type ListBalancesByAddressReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalancesByAddressReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalancesByAddressReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalancesByAddressReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalancesByAddressReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalancesByAddressReplyValidationError) ErrorName() string {
+	return "ListBalancesByAddressReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalancesByAddressReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalancesByAddressReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalancesByAddressReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalancesByAddressReplyValidationError{}
+
+// Validate checks the field values on ListHoldersByTickRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHoldersByTickRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHoldersByTickRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHoldersByTickRequestMultiError, or nil if none found.
+func (m *ListHoldersByTickRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHoldersByTickRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ListHoldersByTickRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHoldersByTickRequestMultiError is an error wrapping multiple validation
+// errors returned by ListHoldersByTickRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListHoldersByTickRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHoldersByTickRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHoldersByTickRequestMultiError) AllErrors() []error { return m }
+
+// ListHoldersByTickRequestValidationError is the validation error returned by
+// ListHoldersByTickRequest.Validate if the designated constraints aren't met.
+type ListHoldersByTickRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHoldersByTickRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHoldersByTickRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHoldersByTickRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHoldersByTickRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHoldersByTickRequestValidationError) ErrorName() string {
+	return "ListHoldersByTickRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHoldersByTickRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHoldersByTickRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHoldersByTickRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHoldersByTickRequestValidationError{}
+
+// Validate checks the field values on ListHoldersByTickReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHoldersByTickReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHoldersByTickReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHoldersByTickReplyMultiError, or nil if none found.
+func (m *ListHoldersByTickReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHoldersByTickReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHolders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListHoldersByTickReplyValidationError{
+						field:  fmt.Sprintf("Holders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListHoldersByTickReplyValidationError{
+						field:  fmt.Sprintf("Holders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListHoldersByTickReplyValidationError{
+					field:  fmt.Sprintf("Holders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListHoldersByTickReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHoldersByTickReplyMultiError is an error wrapping multiple validation
+// errors returned by ListHoldersByTickReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListHoldersByTickReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHoldersByTickReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHoldersByTickReplyMultiError) AllErrors() []error { return m }
+
+// ListHoldersByTickReplyValidationError is the validation error returned by
+// ListHoldersByTickReply.Validate if the designated constraints aren't met.
+type ListHoldersByTickReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHoldersByTickReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHoldersByTickReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHoldersByTickReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHoldersByTickReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHoldersByTickReplyValidationError) ErrorName() string {
+	return "ListHoldersByTickReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHoldersByTickReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHoldersByTickReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHoldersByTickReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHoldersByTickReplyValidationError{}
+
+// Validate checks the field values on GetBalanceAtRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceAtRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBalanceAtRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceAtRequestMultiError, or nil if none found.
+func (m *GetBalanceAtRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBalanceAtRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Tick
+
+	// no validation rules for BlockNumber
+
+	if len(errors) > 0 {
+		return GetBalanceAtRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBalanceAtRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBalanceAtRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBalanceAtRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBalanceAtRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBalanceAtRequestMultiError) AllErrors() []error { return m }
+
+// GetBalanceAtRequestValidationError is the validation error returned by
+// GetBalanceAtRequest.Validate if the designated constraints aren't met.
+type GetBalanceAtRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBalanceAtRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBalanceAtRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBalanceAtRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBalanceAtRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBalanceAtRequestValidationError) ErrorName() string {
+	return "GetBalanceAtRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBalanceAtRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBalanceAtRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBalanceAtRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBalanceAtRequestValidationError{}
+
+// Validate checks the field values on GetBalanceAtReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceAtReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBalanceAtReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceAtReplyMultiError, or nil if none found.
+func (m *GetBalanceAtReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBalanceAtReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBalance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBalanceAtReplyValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBalanceAtReplyValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBalance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBalanceAtReplyValidationError{
+				field:  "Balance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBalanceAtReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBalanceAtReplyMultiError is an error wrapping multiple validation errors
+// returned by GetBalanceAtReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetBalanceAtReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBalanceAtReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBalanceAtReplyMultiError) AllErrors() []error { return m }
+
+// GetBalanceAtReplyValidationError is the validation error returned by
+// GetBalanceAtReply.Validate if the designated constraints aren't met.
+type GetBalanceAtReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBalanceAtReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBalanceAtReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBalanceAtReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBalanceAtReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBalanceAtReplyValidationError) ErrorName() string {
+	return "GetBalanceAtReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBalanceAtReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBalanceAtReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBalanceAtReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBalanceAtReplyValidationError{}
+
+// Validate checks the field values on BalanceChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BalanceChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BalanceChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BalanceChangeMultiError, or
+// nil if none found.
+func (m *BalanceChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BalanceChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Tick
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for Available
+
+	// no validation rules for Freeze
+
+	// no validation rules for Minted
+
+	// no validation rules for AvailableDelta
+
+	// no validation rules for FreezeDelta
+
+	// no validation rules for MintedDelta
+
+	if len(errors) > 0 {
+		return BalanceChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalanceChangeMultiError is an error wrapping multiple validation errors
+// returned by BalanceChange.ValidateAll() if the designated constraints
+// aren't met.
+type BalanceChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalanceChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalanceChangeMultiError) AllErrors() []error { return m }
+
+// BalanceChangeValidationError is the validation error returned by
+// BalanceChange.Validate if the designated constraints aren't met.
+type BalanceChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalanceChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalanceChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalanceChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalanceChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalanceChangeValidationError) ErrorName() string { return "BalanceChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BalanceChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalanceChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalanceChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalanceChangeValidationError{}
+
+// Validate checks the field values on ListBalanceChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalanceChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalanceChangesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalanceChangesRequestMultiError, or nil if none found.
+func (m *ListBalanceChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalanceChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Tick
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ListBalanceChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalanceChangesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBalanceChangesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListBalanceChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalanceChangesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalanceChangesRequestMultiError) AllErrors() []error { return m }
+
+// ListBalanceChangesRequestValidationError is the validation error returned by
+// ListBalanceChangesRequest.Validate if the designated constraints aren't met.
+type ListBalanceChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalanceChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalanceChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalanceChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalanceChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalanceChangesRequestValidationError) ErrorName() string {
+	return "ListBalanceChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalanceChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalanceChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalanceChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalanceChangesRequestValidationError{}
+
+// Validate checks the field values on ListBalanceChangesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalanceChangesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalanceChangesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalanceChangesReplyMultiError, or nil if none found.
+func (m *ListBalanceChangesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalanceChangesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBalanceChangesReplyValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBalanceChangesReplyValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBalanceChangesReplyValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListBalanceChangesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalanceChangesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListBalanceChangesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListBalanceChangesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalanceChangesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalanceChangesReplyMultiError) AllErrors() []error { return m }
+
+// ListBalanceChangesReplyValidationError is the validation error returned by
+// ListBalanceChangesReply.Validate if the designated constraints aren't met.
+type ListBalanceChangesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalanceChangesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalanceChangesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalanceChangesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalanceChangesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalanceChangesReplyValidationError) ErrorName() string {
+	return "ListBalanceChangesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalanceChangesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalanceChangesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalanceChangesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalanceChangesReplyValidationError{}
+
+// Validate checks the field values on Tick with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tick) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tick with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TickMultiError, or nil if none found.
+func (m *Tick) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tick) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for Tick
+
+	// no validation rules for Decimals
+
+	// no validation rules for MaxSupply
+
+	// no validation rules for Supply
+
+	// no validation rules for Creator
+
+	// no validation rules for LastUpdatedBlock
+
+	switch v := m.Detail.(type) {
+	case *Tick_Ierc20:
+		if v == nil {
+			err := TickValidationError{
+				field:  "Detail",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetIerc20()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TickValidationError{
+						field:  "Ierc20",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TickValidationError{
+						field:  "Ierc20",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetIerc20()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TickValidationError{
+					field:  "Ierc20",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Tick_IercPow:
+		if v == nil {
+			err := TickValidationError{
+				field:  "Detail",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetIercPow()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TickValidationError{
+						field:  "IercPow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TickValidationError{
+						field:  "IercPow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetIercPow()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TickValidationError{
+					field:  "IercPow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return TickMultiError(errors)
+	}
+
+	return nil
+}
+
+// TickMultiError is an error wrapping multiple validation errors returned by
+// Tick.ValidateAll() if the designated constraints aren't met.
+type TickMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TickMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TickMultiError) AllErrors() []error { return m }
+
+// TickValidationError is the validation error returned by Tick.Validate if the
+// designated constraints aren't met.
+type TickValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TickValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TickValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TickValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TickValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TickValidationError) ErrorName() string { return "TickValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TickValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTick.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TickValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TickValidationError{}
+
+// Validate checks the field values on GetTickRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTickRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTickRequestMultiError,
+// or nil if none found.
+func (m *GetTickRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	if len(errors) > 0 {
+		return GetTickRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTickRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTickRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickRequestMultiError) AllErrors() []error { return m }
+
+// GetTickRequestValidationError is the validation error returned by
+// GetTickRequest.Validate if the designated constraints aren't met.
+type GetTickRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickRequestValidationError) ErrorName() string { return "GetTickRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTickRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickRequestValidationError{}
+
+// Validate checks the field values on GetTickReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTickReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTickReplyMultiError, or
+// nil if none found.
+func (m *GetTickReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTick()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTickReplyValidationError{
+					field:  "Tick",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTickReplyValidationError{
+					field:  "Tick",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTick()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTickReplyValidationError{
+				field:  "Tick",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTickReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTickReply.ValidateAll() if the designated constraints aren't met.
+type GetTickReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickReplyMultiError) AllErrors() []error { return m }
+
+// GetTickReplyValidationError is the validation error returned by
+// GetTickReply.Validate if the designated constraints aren't met.
+type GetTickReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickReplyValidationError) ErrorName() string { return "GetTickReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTickReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickReplyValidationError{}
+
+// Validate checks the field values on ListTicksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTicksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTicksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTicksRequestMultiError, or nil if none found.
+func (m *ListTicksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTicksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for Prefix
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ListTicksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTicksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTicksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTicksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTicksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTicksRequestMultiError) AllErrors() []error { return m }
+
+// ListTicksRequestValidationError is the validation error returned by
+// ListTicksRequest.Validate if the designated constraints aren't met.
+type ListTicksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTicksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTicksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTicksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTicksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTicksRequestValidationError) ErrorName() string { return "ListTicksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTicksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTicksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTicksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTicksRequestValidationError{}
+
+// Validate checks the field values on ListTicksReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTicksReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTicksReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTicksReplyMultiError,
+// or nil if none found.
+func (m *ListTicksReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTicksReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTicks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTicksReplyValidationError{
+						field:  fmt.Sprintf("Ticks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTicksReplyValidationError{
+						field:  fmt.Sprintf("Ticks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTicksReplyValidationError{
+					field:  fmt.Sprintf("Ticks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListTicksReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTicksReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTicksReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTicksReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTicksReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTicksReplyMultiError) AllErrors() []error { return m }
+
+// ListTicksReplyValidationError is the validation error returned by
+// ListTicksReply.Validate if the designated constraints aren't met.
+type ListTicksReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTicksReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTicksReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTicksReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTicksReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTicksReplyValidationError) ErrorName() string { return "ListTicksReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTicksReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTicksReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTicksReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTicksReplyValidationError{}
+
+// Validate checks the field values on StakingPool with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StakingPool) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StakingPool with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StakingPoolMultiError, or
+// nil if none found.
+func (m *StakingPool) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StakingPool) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pool
+
+	// no validation rules for PoolSubId
+
+	// no validation rules for Name
+
+	// no validation rules for Owner
+
+	// no validation rules for StartBlock
+
+	// no validation rules for StopBlock
+
+	for idx, item := range m.GetTickDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StakingPoolValidationError{
+						field:  fmt.Sprintf("TickDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StakingPoolValidationError{
+						field:  fmt.Sprintf("TickDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StakingPoolValidationError{
+					field:  fmt.Sprintf("TickDetails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LastUpdatedBlock
+
+	if len(errors) > 0 {
+		return StakingPoolMultiError(errors)
+	}
+
+	return nil
+}
+
+// StakingPoolMultiError is an error wrapping multiple validation errors
+// returned by StakingPool.ValidateAll() if the designated constraints aren't met.
+type StakingPoolMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StakingPoolMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StakingPoolMultiError) AllErrors() []error { return m }
+
+// StakingPoolValidationError is the validation error returned by
+// StakingPool.Validate if the designated constraints aren't met.
+type StakingPoolValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StakingPoolValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StakingPoolValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StakingPoolValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StakingPoolValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StakingPoolValidationError) ErrorName() string { return "StakingPoolValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StakingPoolValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStakingPool.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StakingPoolValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StakingPoolValidationError{}
+
+// Validate checks the field values on StakingPosition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StakingPosition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StakingPosition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StakingPositionMultiError, or nil if none found.
+func (m *StakingPosition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StakingPosition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pool
+
+	// no validation rules for PoolSubId
+
+	// no validation rules for Staker
+
+	for idx, item := range m.GetTickDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StakingPositionValidationError{
+						field:  fmt.Sprintf("TickDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StakingPositionValidationError{
+						field:  fmt.Sprintf("TickDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StakingPositionValidationError{
+					field:  fmt.Sprintf("TickDetails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RewardsPerBlock
+
+	// no validation rules for AccRewards
+
+	// no validation rules for Debt
+
+	// no validation rules for AvailableRewards
+
+	// no validation rules for RewardsBlock
+
+	// no validation rules for LastRewardBlock
+
+	// no validation rules for LastUpdatedBlock
+
+	if len(errors) > 0 {
+		return StakingPositionMultiError(errors)
+	}
+
+	return nil
+}
+
+// StakingPositionMultiError is an error wrapping multiple validation errors
+// returned by StakingPosition.ValidateAll() if the designated constraints
+// aren't met.
+type StakingPositionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StakingPositionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StakingPositionMultiError) AllErrors() []error { return m }
+
+// StakingPositionValidationError is the validation error returned by
+// StakingPosition.Validate if the designated constraints aren't met.
+type StakingPositionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StakingPositionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StakingPositionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StakingPositionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StakingPositionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StakingPositionValidationError) ErrorName() string { return "StakingPositionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StakingPositionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStakingPosition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StakingPositionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StakingPositionValidationError{}
+
+// Validate checks the field values on ListStakingPoolsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStakingPoolsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStakingPoolsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStakingPoolsRequestMultiError, or nil if none found.
+func (m *ListStakingPoolsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStakingPoolsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Owner
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ListStakingPoolsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStakingPoolsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStakingPoolsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStakingPoolsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStakingPoolsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStakingPoolsRequestMultiError) AllErrors() []error { return m }
+
+// ListStakingPoolsRequestValidationError is the validation error returned by
+// ListStakingPoolsRequest.Validate if the designated constraints aren't met.
+type ListStakingPoolsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStakingPoolsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStakingPoolsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStakingPoolsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStakingPoolsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStakingPoolsRequestValidationError) ErrorName() string {
+	return "ListStakingPoolsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStakingPoolsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStakingPoolsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStakingPoolsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStakingPoolsRequestValidationError{}
+
+// Validate checks the field values on ListStakingPoolsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStakingPoolsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStakingPoolsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStakingPoolsReplyMultiError, or nil if none found.
+func (m *ListStakingPoolsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStakingPoolsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPools() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStakingPoolsReplyValidationError{
+						field:  fmt.Sprintf("Pools[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStakingPoolsReplyValidationError{
+						field:  fmt.Sprintf("Pools[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStakingPoolsReplyValidationError{
+					field:  fmt.Sprintf("Pools[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListStakingPoolsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStakingPoolsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListStakingPoolsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListStakingPoolsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStakingPoolsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStakingPoolsReplyMultiError) AllErrors() []error { return m }
+
+// ListStakingPoolsReplyValidationError is the validation error returned by
+// ListStakingPoolsReply.Validate if the designated constraints aren't met.
+type ListStakingPoolsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStakingPoolsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStakingPoolsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStakingPoolsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStakingPoolsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStakingPoolsReplyValidationError) ErrorName() string {
+	return "ListStakingPoolsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStakingPoolsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStakingPoolsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStakingPoolsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStakingPoolsReplyValidationError{}
+
+// Validate checks the field values on GetStakingPoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStakingPoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStakingPoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStakingPoolRequestMultiError, or nil if none found.
+func (m *GetStakingPoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStakingPoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pool
+
+	// no validation rules for PoolSubId
+
+	if len(errors) > 0 {
+		return GetStakingPoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStakingPoolRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStakingPoolRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStakingPoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStakingPoolRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStakingPoolRequestMultiError) AllErrors() []error { return m }
+
+// GetStakingPoolRequestValidationError is the validation error returned by
+// GetStakingPoolRequest.Validate if the designated constraints aren't met.
+type GetStakingPoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStakingPoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStakingPoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStakingPoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStakingPoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStakingPoolRequestValidationError) ErrorName() string {
+	return "GetStakingPoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStakingPoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStakingPoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStakingPoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStakingPoolRequestValidationError{}
+
+// Validate checks the field values on GetStakingPoolReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStakingPoolReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStakingPoolReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStakingPoolReplyMultiError, or nil if none found.
+func (m *GetStakingPoolReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStakingPoolReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPool()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetStakingPoolReplyValidationError{
+					field:  "Pool",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetStakingPoolReplyValidationError{
+					field:  "Pool",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPool()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetStakingPoolReplyValidationError{
+				field:  "Pool",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetStakingPoolReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStakingPoolReplyMultiError is an error wrapping multiple validation
+// errors returned by GetStakingPoolReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetStakingPoolReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStakingPoolReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStakingPoolReplyMultiError) AllErrors() []error { return m }
+
+// GetStakingPoolReplyValidationError is the validation error returned by
+// GetStakingPoolReply.Validate if the designated constraints aren't met.
+type GetStakingPoolReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStakingPoolReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStakingPoolReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStakingPoolReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStakingPoolReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStakingPoolReplyValidationError) ErrorName() string {
+	return "GetStakingPoolReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStakingPoolReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStakingPoolReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStakingPoolReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStakingPoolReplyValidationError{}
+
+// Validate checks the field values on ListStakingPositionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStakingPositionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStakingPositionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStakingPositionsRequestMultiError, or nil if none found.
+func (m *ListStakingPositionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStakingPositionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Staker
+
+	// no validation rules for Pool
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ListStakingPositionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStakingPositionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListStakingPositionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListStakingPositionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStakingPositionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStakingPositionsRequestMultiError) AllErrors() []error { return m }
+
+// ListStakingPositionsRequestValidationError is the validation error returned
+// by ListStakingPositionsRequest.Validate if the designated constraints
+// aren't met.
+type ListStakingPositionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStakingPositionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStakingPositionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStakingPositionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStakingPositionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStakingPositionsRequestValidationError) ErrorName() string {
+	return "ListStakingPositionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStakingPositionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStakingPositionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStakingPositionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStakingPositionsRequestValidationError{}
+
+// Validate checks the field values on ListStakingPositionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStakingPositionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStakingPositionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStakingPositionsReplyMultiError, or nil if none found.
+func (m *ListStakingPositionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStakingPositionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPositions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStakingPositionsReplyValidationError{
+						field:  fmt.Sprintf("Positions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStakingPositionsReplyValidationError{
+						field:  fmt.Sprintf("Positions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStakingPositionsReplyValidationError{
+					field:  fmt.Sprintf("Positions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListStakingPositionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStakingPositionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListStakingPositionsReply.ValidateAll() if the
+// designated constraints aren't met.
+type ListStakingPositionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStakingPositionsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStakingPositionsReplyMultiError) AllErrors() []error { return m }
+
+// ListStakingPositionsReplyValidationError is the validation error returned by
+// ListStakingPositionsReply.Validate if the designated constraints aren't met.
+type ListStakingPositionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStakingPositionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStakingPositionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStakingPositionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStakingPositionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStakingPositionsReplyValidationError) ErrorName() string {
+	return "ListStakingPositionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStakingPositionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStakingPositionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStakingPositionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStakingPositionsReplyValidationError{}
+
+// Validate checks the field values on Listing with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Listing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Listing with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ListingMultiError, or nil if none found.
+func (m *Listing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Listing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Sign
+
+	// no validation rules for Protocol
+
+	// no validation rules for Tick
+
+	// no validation rules for Seller
+
+	// no validation rules for Platform
+
+	// no validation rules for Amount
+
+	// no validation rules for Value
+
+	// no validation rules for Price
+
+	// no validation rules for ListedTxHash
+
+	// no validation rules for ListedBlock
+
+	// no validation rules for Buyer
+
+	// no validation rules for ClosedTxHash
+
+	// no validation rules for ClosedBlock
+
+	if len(errors) > 0 {
+		return ListingMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListingMultiError is an error wrapping multiple validation errors returned
+// by Listing.ValidateAll() if the designated constraints aren't met.
+type ListingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListingMultiError) AllErrors() []error { return m }
+
+// ListingValidationError is the validation error returned by Listing.Validate
+// if the designated constraints aren't met.
+type ListingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListingValidationError) ErrorName() string { return "ListingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListingValidationError{}
+
+// Validate checks the field values on GetListingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetListingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListingRequestMultiError, or nil if none found.
+func (m *GetListingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sign
+
+	if len(errors) > 0 {
+		return GetListingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListingRequestMultiError is an error wrapping multiple validation errors
+// returned by GetListingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetListingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListingRequestMultiError) AllErrors() []error { return m }
+
+// GetListingRequestValidationError is the validation error returned by
+// GetListingRequest.Validate if the designated constraints aren't met.
+type GetListingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListingRequestValidationError) ErrorName() string {
+	return "GetListingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListingRequestValidationError{}
+
+// Validate checks the field values on GetListingReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetListingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListingReplyMultiError, or nil if none found.
+func (m *GetListingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetListing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListingReplyValidationError{
+					field:  "Listing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListingReplyValidationError{
+					field:  "Listing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetListing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListingReplyValidationError{
+				field:  "Listing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListingReplyMultiError is an error wrapping multiple validation errors
+// returned by GetListingReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetListingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListingReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListingReplyMultiError) AllErrors() []error { return m }
+
+// GetListingReplyValidationError is the validation error returned by
+// GetListingReply.Validate if the designated constraints aren't met.
+type GetListingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListingReplyValidationError) ErrorName() string { return "GetListingReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetListingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListingReplyValidationError{}
+
+// Validate checks the field values on ListListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListListingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListListingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListListingsRequestMultiError, or nil if none found.
+func (m *ListListingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListListingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Seller
+
+	// no validation rules for Status
+
+	// no validation rules for OrderByPrice
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ListListingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListListingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListListingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListListingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListListingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListListingsRequestMultiError) AllErrors() []error { return m }
+
+// ListListingsRequestValidationError is the validation error returned by
+// ListListingsRequest.Validate if the designated constraints aren't met.
+type ListListingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListListingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListListingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListListingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListListingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListListingsRequestValidationError) ErrorName() string {
+	return "ListListingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListListingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListListingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListListingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListListingsRequestValidationError{}
+
+// Validate checks the field values on ListListingsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListListingsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListListingsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListListingsReplyMultiError, or nil if none found.
+func (m *ListListingsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListListingsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetListings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListListingsReplyValidationError{
+						field:  fmt.Sprintf("Listings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListListingsReplyValidationError{
+						field:  fmt.Sprintf("Listings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListListingsReplyValidationError{
+					field:  fmt.Sprintf("Listings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListListingsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListListingsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListListingsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListListingsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListListingsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListListingsReplyMultiError) AllErrors() []error { return m }
+
+// ListListingsReplyValidationError is the validation error returned by
+// ListListingsReply.Validate if the designated constraints aren't met.
+type ListListingsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListListingsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListListingsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListListingsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListListingsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListListingsReplyValidationError) ErrorName() string {
+	return "ListListingsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListListingsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListListingsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListListingsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListListingsReplyValidationError{}
+
+// Validate checks the field values on TickStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TickStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TickStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TickStatsMultiError, or nil
+// if none found.
+func (m *TickStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TickStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for Day
+
+	// no validation rules for HolderDelta
+
+	// no validation rules for Transfers
+
+	// no validation rules for Mints
+
+	// no validation rules for Minted
+
+	// no validation rules for Trades
+
+	// no validation rules for Volume
+
+	// no validation rules for Holders
+
+	// no validation rules for TotalTransfers
+
+	// no validation rules for TotalMints
+
+	// no validation rules for TotalMinted
+
+	// no validation rules for TotalTrades
+
+	// no validation rules for TotalVolume
+
+	// no validation rules for LastPrice
+
+	if len(errors) > 0 {
+		return TickStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TickStatsMultiError is an error wrapping multiple validation errors returned
+// by TickStats.ValidateAll() if the designated constraints aren't met.
+type TickStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TickStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TickStatsMultiError) AllErrors() []error { return m }
+
+// TickStatsValidationError is the validation error returned by
+// TickStats.Validate if the designated constraints aren't met.
+type TickStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TickStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TickStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TickStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TickStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TickStatsValidationError) ErrorName() string { return "TickStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TickStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTickStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TickStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TickStatsValidationError{}
+
+// Validate checks the field values on GetTickStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTickStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTickStatsRequestMultiError, or nil if none found.
+func (m *GetTickStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	if len(errors) > 0 {
+		return GetTickStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTickStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTickStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetTickStatsRequestValidationError is the validation error returned by
+// GetTickStatsRequest.Validate if the designated constraints aren't met.
+type GetTickStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickStatsRequestValidationError) ErrorName() string {
+	return "GetTickStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTickStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickStatsRequestValidationError{}
+
+// Validate checks the field values on GetTickStatsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTickStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTickStatsReplyMultiError, or nil if none found.
+func (m *GetTickStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTickStatsReplyValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTickStatsReplyValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTickStatsReplyValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTickStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickStatsReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTickStatsReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetTickStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetTickStatsReplyValidationError is the validation error returned by
+// GetTickStatsReply.Validate if the designated constraints aren't met.
+type GetTickStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickStatsReplyValidationError) ErrorName() string {
+	return "GetTickStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTickStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickStatsReplyValidationError{}
+
+// Validate checks the field values on ListTickStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTickStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTickStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTickStatsRequestMultiError, or nil if none found.
+func (m *ListTickStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTickStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Period
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ListTickStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTickStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTickStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTickStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTickStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTickStatsRequestMultiError) AllErrors() []error { return m }
+
+// ListTickStatsRequestValidationError is the validation error returned by
+// ListTickStatsRequest.Validate if the designated constraints aren't met.
+type ListTickStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTickStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTickStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTickStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTickStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTickStatsRequestValidationError) ErrorName() string {
+	return "ListTickStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTickStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTickStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTickStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTickStatsRequestValidationError{}
+
+// Validate checks the field values on ListTickStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTickStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTickStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTickStatsReplyMultiError, or nil if none found.
+func (m *ListTickStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTickStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTickStatsReplyValidationError{
+						field:  fmt.Sprintf("Stats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTickStatsReplyValidationError{
+						field:  fmt.Sprintf("Stats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTickStatsReplyValidationError{
+					field:  fmt.Sprintf("Stats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListTickStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTickStatsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTickStatsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTickStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTickStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTickStatsReplyMultiError) AllErrors() []error { return m }
+
+// ListTickStatsReplyValidationError is the validation error returned by
+// ListTickStatsReply.Validate if the designated constraints aren't met.
+type ListTickStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTickStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTickStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTickStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTickStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTickStatsReplyValidationError) ErrorName() string {
+	return "ListTickStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTickStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTickStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTickStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTickStatsReplyValidationError{}
+
+// Validate checks the field values on IERCTransaction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransactionMultiError, or nil if none found.
+func (m *IERCTransaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for Operate
+
+	switch v := m.Command.(type) {
+	case *IERCTransaction_Deploy_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeploy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Deploy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Deploy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeploy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "Deploy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_Mint_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMint()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Mint",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Mint",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMint()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "Mint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_DeployPow:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeployPow()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "DeployPow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "DeployPow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeployPow()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "DeployPow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_MintPow:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMintPow()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "MintPow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "MintPow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMintPow()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "MintPow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_Transfer_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransfer()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Transfer",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Transfer",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_FreezeSell_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFreezeSell()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "FreezeSell",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "FreezeSell",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFreezeSell()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "FreezeSell",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_UnfreezeSell_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUnfreezeSell()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "UnfreezeSell",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "UnfreezeSell",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnfreezeSell()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "UnfreezeSell",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_ProxyTransfer_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProxyTransfer()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "ProxyTransfer",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "ProxyTransfer",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProxyTransfer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "ProxyTransfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_ConfigStake_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetConfigStake()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "ConfigStake",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "ConfigStake",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConfigStake()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "ConfigStake",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_Staking_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetStaking()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Staking",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Staking",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStaking()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "Staking",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_Modify_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetModify()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Modify",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "Modify",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetModify()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "Modify",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IERCTransaction_ClaimAirdrop_:
+		if v == nil {
+			err := IERCTransactionValidationError{
+				field:  "Command",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetClaimAirdrop()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "ClaimAirdrop",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransactionValidationError{
+						field:  "ClaimAirdrop",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetClaimAirdrop()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransactionValidationError{
+					field:  "ClaimAirdrop",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return IERCTransactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransactionMultiError is an error wrapping multiple validation errors
+// returned by IERCTransaction.ValidateAll() if the designated constraints
+// aren't met.
+type IERCTransactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransactionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransactionMultiError) AllErrors() []error { return m }
+
+// IERCTransactionValidationError is the validation error returned by
+// IERCTransaction.Validate if the designated constraints aren't met.
+type IERCTransactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransactionValidationError) ErrorName() string { return "IERCTransactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IERCTransactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransactionValidationError{}
+
+// Validate checks the field values on Transaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Transaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Transaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransactionMultiError, or
+// nil if none found.
+func (m *Transaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Transaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for Position
+
+	// no validation rules for Hash
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Value
+
+	// no validation rules for Gas
+
+	// no validation rules for GasPrice
+
+	// no validation rules for Nonce
+
+	// no validation rules for Data
+
+	// no validation rules for IsProcessed
+
+	// no validation rules for Code
+
+	// no validation rules for Remark
+
+	if all {
+		switch v := interface{}(m.GetIercTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "IercTransaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "IercTransaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIercTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionValidationError{
+				field:  "IercTransaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionMultiError is an error wrapping multiple validation errors
+// returned by Transaction.ValidateAll() if the designated constraints aren't met.
+type TransactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionMultiError) AllErrors() []error { return m }
+
+// TransactionValidationError is the validation error returned by
+// Transaction.Validate if the designated constraints aren't met.
+type TransactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionValidationError) ErrorName() string { return "TransactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionValidationError{}
+
+// Validate checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionRequestMultiError, or nil if none found.
+func (m *GetTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hash
+
+	if len(errors) > 0 {
+		return GetTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionRequestMultiError) AllErrors() []error { return m }
+
+// GetTransactionRequestValidationError is the validation error returned by
+// GetTransactionRequest.Validate if the designated constraints aren't met.
+type GetTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionRequestValidationError) ErrorName() string {
+	return "GetTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionRequestValidationError{}
+
+// Validate checks the field values on GetTransactionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionReplyMultiError, or nil if none found.
+func (m *GetTransactionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTransactionReplyValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTransactionReplyValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTransactionReplyValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTransactionReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTransactionReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTransactionReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetTransactionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionReplyMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionReplyMultiError) AllErrors() []error { return m }
+
+// GetTransactionReplyValidationError is the validation error returned by
+// GetTransactionReply.Validate if the designated constraints aren't met.
+type GetTransactionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionReplyValidationError) ErrorName() string {
+	return "GetTransactionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionReplyValidationError{}
+
+// Validate checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Webhook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WebhookMultiError, or nil if none found.
+func (m *Webhook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Webhook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for IncludeFailed
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return WebhookMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookMultiError is an error wrapping multiple validation errors returned
+// by Webhook.ValidateAll() if the designated constraints aren't met.
+type WebhookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookMultiError) AllErrors() []error { return m }
+
+// WebhookValidationError is the validation error returned by Webhook.Validate
+// if the designated constraints aren't met.
+type WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookValidationError) ErrorName() string { return "WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookValidationError{}
+
+// Validate checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for IncludeFailed
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookRequestValidationError{}
+
+// Validate checks the field values on CreateWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookReplyMultiError, or nil if none found.
+func (m *CreateWebhookReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookReplyValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWebhookReplyValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookReplyValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateWebhookReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateWebhookReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateWebhookReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookReplyMultiError) AllErrors() []error { return m }
+
+// CreateWebhookReplyValidationError is the validation error returned by
+// CreateWebhookReply.Validate if the designated constraints aren't met.
+type CreateWebhookReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookReplyValidationError) ErrorName() string {
+	return "CreateWebhookReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookReplyValidationError{}
+
+// Validate checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksRequestMultiError, or nil if none found.
+func (m *ListWebhooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListWebhooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhooksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhooksRequestValidationError is the validation error returned by
+// ListWebhooksRequest.Validate if the designated constraints aren't met.
+type ListWebhooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksRequestValidationError) ErrorName() string {
+	return "ListWebhooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksRequestValidationError{}
+
+// Validate checks the field values on ListWebhooksReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksReplyMultiError, or nil if none found.
+func (m *ListWebhooksReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWebhooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhooksReplyValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhooksReplyValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhooksReplyValidationError{
+					field:  fmt.Sprintf("Webhooks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhooksReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhooksReplyMultiError is an error wrapping multiple validation errors
+// returned by ListWebhooksReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListWebhooksReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksReplyMultiError) AllErrors() []error { return m }
+
+// ListWebhooksReplyValidationError is the validation error returned by
+// ListWebhooksReply.Validate if the designated constraints aren't met.
+type ListWebhooksReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksReplyValidationError) ErrorName() string {
+	return "ListWebhooksReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksReplyValidationError{}
+
+// Validate checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookRequestValidationError{}
+
+// Validate checks the field values on DeleteWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookReplyMultiError, or nil if none found.
+func (m *DeleteWebhookReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteWebhookReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteWebhookReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteWebhookReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookReplyMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookReplyValidationError is the validation error returned by
+// DeleteWebhookReply.Validate if the designated constraints aren't met.
+type DeleteWebhookReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookReplyValidationError) ErrorName() string {
+	return "DeleteWebhookReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookReplyValidationError{}
+
+// Validate checks the field values on QueryEventsReply_EventsByBlock with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryEventsReply_EventsByBlock) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryEventsReply_EventsByBlock with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryEventsReply_EventsByBlockMultiError, or nil if none found.
+func (m *QueryEventsReply_EventsByBlock) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryEventsReply_EventsByBlock) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for PrevBlockNumber
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryEventsReply_EventsByBlockValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryEventsReply_EventsByBlockValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryEventsReply_EventsByBlockValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueryEventsReply_EventsByBlockMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryEventsReply_EventsByBlockMultiError is an error wrapping multiple
+// validation errors returned by QueryEventsReply_EventsByBlock.ValidateAll()
+// if the designated constraints aren't met.
+type QueryEventsReply_EventsByBlockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryEventsReply_EventsByBlockMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryEventsReply_EventsByBlockMultiError) AllErrors() []error { return m }
+
+// QueryEventsReply_EventsByBlockValidationError is the validation error
+// returned by QueryEventsReply_EventsByBlock.Validate if the designated
+// constraints aren't met.
+type QueryEventsReply_EventsByBlockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryEventsReply_EventsByBlockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryEventsReply_EventsByBlockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryEventsReply_EventsByBlockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryEventsReply_EventsByBlockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryEventsReply_EventsByBlockValidationError) ErrorName() string {
+	return "QueryEventsReply_EventsByBlockValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryEventsReply_EventsByBlockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryEventsReply_EventsByBlock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryEventsReply_EventsByBlockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryEventsReply_EventsByBlockValidationError{}
+
+// Validate checks the field values on CheckTransferReply_TransferRecord with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckTransferReply_TransferRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckTransferReply_TransferRecord
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckTransferReply_TransferRecordMultiError, or nil if none found.
+func (m *CheckTransferReply_TransferRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckTransferReply_TransferRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sender
+
+	// no validation rules for Receiver
+
+	// no validation rules for Tick
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return CheckTransferReply_TransferRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckTransferReply_TransferRecordMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckTransferReply_TransferRecord.ValidateAll() if the designated
+// constraints aren't met.
+type CheckTransferReply_TransferRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckTransferReply_TransferRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckTransferReply_TransferRecordMultiError) AllErrors() []error { return m }
+
+// CheckTransferReply_TransferRecordValidationError is the validation error
+// returned by CheckTransferReply_TransferRecord.Validate if the designated
+// constraints aren't met.
+type CheckTransferReply_TransferRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckTransferReply_TransferRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckTransferReply_TransferRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckTransferReply_TransferRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckTransferReply_TransferRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckTransferReply_TransferRecordValidationError) ErrorName() string {
+	return "CheckTransferReply_TransferRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckTransferReply_TransferRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckTransferReply_TransferRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckTransferReply_TransferRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckTransferReply_TransferRecordValidationError{}
+
+// Validate checks the field values on Tick_IERC20Detail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Tick_IERC20Detail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tick_IERC20Detail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Tick_IERC20DetailMultiError, or nil if none found.
+func (m *Tick_IERC20Detail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tick_IERC20Detail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for WalletLimit
+
+	// no validation rules for Workc
+
+	if len(errors) > 0 {
+		return Tick_IERC20DetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// Tick_IERC20DetailMultiError is an error wrapping multiple validation errors
+// returned by Tick_IERC20Detail.ValidateAll() if the designated constraints
+// aren't met.
+type Tick_IERC20DetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Tick_IERC20DetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Tick_IERC20DetailMultiError) AllErrors() []error { return m }
+
+// Tick_IERC20DetailValidationError is the validation error returned by
+// Tick_IERC20Detail.Validate if the designated constraints aren't met.
+type Tick_IERC20DetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Tick_IERC20DetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Tick_IERC20DetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Tick_IERC20DetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Tick_IERC20DetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Tick_IERC20DetailValidationError) ErrorName() string {
+	return "Tick_IERC20DetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Tick_IERC20DetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTick_IERC20Detail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Tick_IERC20DetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Tick_IERC20DetailValidationError{}
+
+// Validate checks the field values on Tick_IERCPoWDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Tick_IERCPoWDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tick_IERCPoWDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Tick_IERCPoWDetailMultiError, or nil if none found.
+func (m *Tick_IERCPoWDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tick_IERCPoWDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokenomicsDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Tick_IERCPoWDetailValidationError{
+						field:  fmt.Sprintf("TokenomicsDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Tick_IERCPoWDetailValidationError{
+						field:  fmt.Sprintf("TokenomicsDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Tick_IERCPoWDetailValidationError{
+					field:  fmt.Sprintf("TokenomicsDetails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Tick_IERCPoWDetailValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Tick_IERCPoWDetailValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Tick_IERCPoWDetailValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PowSupply
+
+	// no validation rules for PosSupply
+
+	// no validation rules for AirdropAmount
+
+	// no validation rules for PowRemainSupply
+
+	// no validation rules for PosRemainSupply
+
+	// no validation rules for RemainSupply
+
+	// no validation rules for ProjectedSupply
+
+	// no validation rules for ProjectedBlock
+
+	if len(errors) > 0 {
+		return Tick_IERCPoWDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// Tick_IERCPoWDetailMultiError is an error wrapping multiple validation errors
+// returned by Tick_IERCPoWDetail.ValidateAll() if the designated constraints
+// aren't met.
+type Tick_IERCPoWDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Tick_IERCPoWDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Tick_IERCPoWDetailMultiError) AllErrors() []error { return m }
+
+// Tick_IERCPoWDetailValidationError is the validation error returned by
+// Tick_IERCPoWDetail.Validate if the designated constraints aren't met.
+type Tick_IERCPoWDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Tick_IERCPoWDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Tick_IERCPoWDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Tick_IERCPoWDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Tick_IERCPoWDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Tick_IERCPoWDetailValidationError) ErrorName() string {
+	return "Tick_IERCPoWDetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Tick_IERCPoWDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTick_IERCPoWDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Tick_IERCPoWDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Tick_IERCPoWDetailValidationError{}
+
+// Validate checks the field values on StakingPool_TickDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StakingPool_TickDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StakingPool_TickDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StakingPool_TickDetailMultiError, or nil if none found.
+func (m *StakingPool_TickDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StakingPool_TickDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Ratio
+
+	// no validation rules for Amount
+
+	// no validation rules for MaxAmount
+
+	// no validation rules for HistoryAmount
+
+	if len(errors) > 0 {
+		return StakingPool_TickDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// StakingPool_TickDetailMultiError is an error wrapping multiple validation
+// errors returned by StakingPool_TickDetail.ValidateAll() if the designated
+// constraints aren't met.
+type StakingPool_TickDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StakingPool_TickDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StakingPool_TickDetailMultiError) AllErrors() []error { return m }
+
+// StakingPool_TickDetailValidationError is the validation error returned by
+// StakingPool_TickDetail.Validate if the designated constraints aren't met.
+type StakingPool_TickDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StakingPool_TickDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StakingPool_TickDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StakingPool_TickDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StakingPool_TickDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StakingPool_TickDetailValidationError) ErrorName() string {
+	return "StakingPool_TickDetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StakingPool_TickDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStakingPool_TickDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StakingPool_TickDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StakingPool_TickDetailValidationError{}
+
+// Validate checks the field values on StakingPosition_TickDetail with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StakingPosition_TickDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StakingPosition_TickDetail with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StakingPosition_TickDetailMultiError, or nil if none found.
+func (m *StakingPosition_TickDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StakingPosition_TickDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Ratio
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return StakingPosition_TickDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// StakingPosition_TickDetailMultiError is an error wrapping multiple
+// validation errors returned by StakingPosition_TickDetail.ValidateAll() if
+// the designated constraints aren't met.
+type StakingPosition_TickDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StakingPosition_TickDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StakingPosition_TickDetailMultiError) AllErrors() []error { return m }
+
+// StakingPosition_TickDetailValidationError is the validation error returned
+// by StakingPosition_TickDetail.Validate if the designated constraints aren't met.
+type StakingPosition_TickDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StakingPosition_TickDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StakingPosition_TickDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StakingPosition_TickDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StakingPosition_TickDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StakingPosition_TickDetailValidationError) ErrorName() string {
+	return "StakingPosition_TickDetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StakingPosition_TickDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStakingPosition_TickDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StakingPosition_TickDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StakingPosition_TickDetailValidationError{}
+
+// Validate checks the field values on IERCTransaction_Deploy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_Deploy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_Deploy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_DeployMultiError, or nil if none found.
+func (m *IERCTransaction_Deploy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_Deploy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Decimals
+
+	// no validation rules for MaxSupply
+
+	// no validation rules for Limit
+
+	// no validation rules for WalletLimit
+
+	// no validation rules for Workc
+
+	// no validation rules for Nonce
+
+	if len(errors) > 0 {
+		return IERCTransaction_DeployMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_DeployMultiError is an error wrapping multiple validation
+// errors returned by IERCTransaction_Deploy.ValidateAll() if the designated
+// constraints aren't met.
+type IERCTransaction_DeployMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_DeployMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_DeployMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_DeployValidationError is the validation error returned by
+// IERCTransaction_Deploy.Validate if the designated constraints aren't met.
+type IERCTransaction_DeployValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_DeployValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_DeployValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_DeployValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_DeployValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_DeployValidationError) ErrorName() string {
+	return "IERCTransaction_DeployValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_DeployValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_Deploy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_DeployValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_DeployValidationError{}
+
+// Validate checks the field values on IERCTransaction_Mint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_Mint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_Mint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_MintMultiError, or nil if none found.
+func (m *IERCTransaction_Mint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_Mint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Amount
+
+	// no validation rules for Nonce
+
+	if len(errors) > 0 {
+		return IERCTransaction_MintMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_MintMultiError is an error wrapping multiple validation
+// errors returned by IERCTransaction_Mint.ValidateAll() if the designated
+// constraints aren't met.
+type IERCTransaction_MintMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_MintMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_MintMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_MintValidationError is the validation error returned by
+// IERCTransaction_Mint.Validate if the designated constraints aren't met.
+type IERCTransaction_MintValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_MintValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_MintValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_MintValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_MintValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_MintValidationError) ErrorName() string {
+	return "IERCTransaction_MintValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_MintValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_Mint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_MintValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_MintValidationError{}
+
+// Validate checks the field values on IERCTransaction_DeployPoW with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_DeployPoW) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_DeployPoW with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_DeployPoWMultiError, or nil if none found.
+func (m *IERCTransaction_DeployPoW) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_DeployPoW) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Decimals
+
+	// no validation rules for MaxSupply
+
+	for idx, item := range m.GetTokenomicsDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransaction_DeployPoWValidationError{
+						field:  fmt.Sprintf("TokenomicsDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransaction_DeployPoWValidationError{
+						field:  fmt.Sprintf("TokenomicsDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransaction_DeployPoWValidationError{
+					field:  fmt.Sprintf("TokenomicsDetails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IERCTransaction_DeployPoWValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IERCTransaction_DeployPoWValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IERCTransaction_DeployPoWValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IERCTransaction_DeployPoWMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_DeployPoWMultiError is an error wrapping multiple validation
+// errors returned by IERCTransaction_DeployPoW.ValidateAll() if the
+// designated constraints aren't met.
+type IERCTransaction_DeployPoWMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_DeployPoWMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_DeployPoWMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_DeployPoWValidationError is the validation error returned by
+// IERCTransaction_DeployPoW.Validate if the designated constraints aren't met.
+type IERCTransaction_DeployPoWValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_DeployPoWValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_DeployPoWValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_DeployPoWValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_DeployPoWValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_DeployPoWValidationError) ErrorName() string {
+	return "IERCTransaction_DeployPoWValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_DeployPoWValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_DeployPoW.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_DeployPoWValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_DeployPoWValidationError{}
+
+// Validate checks the field values on IERCTransaction_MintPoW with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_MintPoW) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_MintPoW with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_MintPoWMultiError, or nil if none found.
+func (m *IERCTransaction_MintPoW) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_MintPoW) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Points
+
+	// no validation rules for Block
+
+	// no validation rules for Nonce
+
+	if len(errors) > 0 {
+		return IERCTransaction_MintPoWMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_MintPoWMultiError is an error wrapping multiple validation
+// errors returned by IERCTransaction_MintPoW.ValidateAll() if the designated
+// constraints aren't met.
+type IERCTransaction_MintPoWMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_MintPoWMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_MintPoWMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_MintPoWValidationError is the validation error returned by
+// IERCTransaction_MintPoW.Validate if the designated constraints aren't met.
+type IERCTransaction_MintPoWValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_MintPoWValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_MintPoWValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_MintPoWValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_MintPoWValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_MintPoWValidationError) ErrorName() string {
+	return "IERCTransaction_MintPoWValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_MintPoWValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_MintPoW.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_MintPoWValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_MintPoWValidationError{}
+
+// Validate checks the field values on IERCTransaction_TransferRecord with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_TransferRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_TransferRecord with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IERCTransaction_TransferRecordMultiError, or nil if none found.
+func (m *IERCTransaction_TransferRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_TransferRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return IERCTransaction_TransferRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_TransferRecordMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_TransferRecord.ValidateAll()
+// if the designated constraints aren't met.
+type IERCTransaction_TransferRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_TransferRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_TransferRecordMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_TransferRecordValidationError is the validation error
+// returned by IERCTransaction_TransferRecord.Validate if the designated
+// constraints aren't met.
+type IERCTransaction_TransferRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_TransferRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_TransferRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_TransferRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_TransferRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_TransferRecordValidationError) ErrorName() string {
+	return "IERCTransaction_TransferRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_TransferRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_TransferRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_TransferRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_TransferRecordValidationError{}
+
+// Validate checks the field values on IERCTransaction_FreezeRecord with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_FreezeRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_FreezeRecord with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_FreezeRecordMultiError, or nil if none found.
+func (m *IERCTransaction_FreezeRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_FreezeRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Platform
+
+	// no validation rules for Seller
+
+	// no validation rules for SellerSign
+
+	// no validation rules for SignNonce
+
+	// no validation rules for Amount
+
+	// no validation rules for Value
+
+	// no validation rules for GasPrice
+
+	if len(errors) > 0 {
+		return IERCTransaction_FreezeRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_FreezeRecordMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_FreezeRecord.ValidateAll() if
+// the designated constraints aren't met.
+type IERCTransaction_FreezeRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_FreezeRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_FreezeRecordMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_FreezeRecordValidationError is the validation error returned
+// by IERCTransaction_FreezeRecord.Validate if the designated constraints
+// aren't met.
+type IERCTransaction_FreezeRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_FreezeRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_FreezeRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_FreezeRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_FreezeRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_FreezeRecordValidationError) ErrorName() string {
+	return "IERCTransaction_FreezeRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_FreezeRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_FreezeRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_FreezeRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_FreezeRecordValidationError{}
+
+// Validate checks the field values on IERCTransaction_UnfreezeRecord with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_UnfreezeRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_UnfreezeRecord with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IERCTransaction_UnfreezeRecordMultiError, or nil if none found.
+func (m *IERCTransaction_UnfreezeRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_UnfreezeRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TxHash
+
+	// no validation rules for Position
+
+	// no validation rules for Sign
+
+	// no validation rules for Msg
+
+	if len(errors) > 0 {
+		return IERCTransaction_UnfreezeRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_UnfreezeRecordMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_UnfreezeRecord.ValidateAll()
+// if the designated constraints aren't met.
+type IERCTransaction_UnfreezeRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_UnfreezeRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_UnfreezeRecordMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_UnfreezeRecordValidationError is the validation error
+// returned by IERCTransaction_UnfreezeRecord.Validate if the designated
+// constraints aren't met.
+type IERCTransaction_UnfreezeRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_UnfreezeRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_UnfreezeRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_UnfreezeRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_UnfreezeRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_UnfreezeRecordValidationError) ErrorName() string {
+	return "IERCTransaction_UnfreezeRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_UnfreezeRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_UnfreezeRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_UnfreezeRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_UnfreezeRecordValidationError{}
+
+// Validate checks the field values on IERCTransaction_ProxyTransferRecord with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IERCTransaction_ProxyTransferRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_ProxyTransferRecord
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IERCTransaction_ProxyTransferRecordMultiError, or nil if none found.
+func (m *IERCTransaction_ProxyTransferRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_ProxyTransferRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Amount
+
+	// no validation rules for Value
+
+	// no validation rules for Sign
+
+	// no validation rules for SignerNonce
+
+	if len(errors) > 0 {
+		return IERCTransaction_ProxyTransferRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_ProxyTransferRecordMultiError is an error wrapping multiple
+// validation errors returned by
+// IERCTransaction_ProxyTransferRecord.ValidateAll() if the designated
+// constraints aren't met.
+type IERCTransaction_ProxyTransferRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_ProxyTransferRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_ProxyTransferRecordMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_ProxyTransferRecordValidationError is the validation error
+// returned by IERCTransaction_ProxyTransferRecord.Validate if the designated
+// constraints aren't met.
+type IERCTransaction_ProxyTransferRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_ProxyTransferRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_ProxyTransferRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_ProxyTransferRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_ProxyTransferRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_ProxyTransferRecordValidationError) ErrorName() string {
+	return "IERCTransaction_ProxyTransferRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_ProxyTransferRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_ProxyTransferRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_ProxyTransferRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_ProxyTransferRecordValidationError{}
+
+// Validate checks the field values on IERCTransaction_ConfigStake with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_ConfigStake) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_ConfigStake with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_ConfigStakeMultiError, or nil if none found.
+func (m *IERCTransaction_ConfigStake) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_ConfigStake) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pool
+
+	// no validation rules for PoolSubId
+
+	// no validation rules for Owner
+
+	// no validation rules for Name
+
+	// no validation rules for StopBlock
+
+	for idx, item := range m.GetDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransaction_ConfigStakeValidationError{
+						field:  fmt.Sprintf("Details[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransaction_ConfigStakeValidationError{
+						field:  fmt.Sprintf("Details[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransaction_ConfigStakeValidationError{
+					field:  fmt.Sprintf("Details[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IERCTransaction_ConfigStakeMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_ConfigStakeMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_ConfigStake.ValidateAll() if
+// the designated constraints aren't met.
+type IERCTransaction_ConfigStakeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_ConfigStakeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_ConfigStakeMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_ConfigStakeValidationError is the validation error returned
+// by IERCTransaction_ConfigStake.Validate if the designated constraints
+// aren't met.
+type IERCTransaction_ConfigStakeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_ConfigStakeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_ConfigStakeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_ConfigStakeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_ConfigStakeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_ConfigStakeValidationError) ErrorName() string {
+	return "IERCTransaction_ConfigStakeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_ConfigStakeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_ConfigStake.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_ConfigStakeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_ConfigStakeValidationError{}
+
+// Validate checks the field values on IERCTransaction_StakingRecord with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_StakingRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_StakingRecord with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IERCTransaction_StakingRecordMultiError, or nil if none found.
+func (m *IERCTransaction_StakingRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_StakingRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Staker
+
+	// no validation rules for Pool
+
+	// no validation rules for PoolSubId
+
+	// no validation rules for Tick
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return IERCTransaction_StakingRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_StakingRecordMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_StakingRecord.ValidateAll()
+// if the designated constraints aren't met.
+type IERCTransaction_StakingRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_StakingRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_StakingRecordMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_StakingRecordValidationError is the validation error
+// returned by IERCTransaction_StakingRecord.Validate if the designated
+// constraints aren't met.
+type IERCTransaction_StakingRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_StakingRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_StakingRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_StakingRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_StakingRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_StakingRecordValidationError) ErrorName() string {
+	return "IERCTransaction_StakingRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_StakingRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_StakingRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_StakingRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_StakingRecordValidationError{}
+
+// Validate checks the field values on IERCTransaction_Modify with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_Modify) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_Modify with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_ModifyMultiError, or nil if none found.
+func (m *IERCTransaction_Modify) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_Modify) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for MaxSupply
+
+	if len(errors) > 0 {
+		return IERCTransaction_ModifyMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_ModifyMultiError is an error wrapping multiple validation
+// errors returned by IERCTransaction_Modify.ValidateAll() if the designated
+// constraints aren't met.
+type IERCTransaction_ModifyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_ModifyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_ModifyMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_ModifyValidationError is the validation error returned by
+// IERCTransaction_Modify.Validate if the designated constraints aren't met.
+type IERCTransaction_ModifyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_ModifyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_ModifyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_ModifyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_ModifyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_ModifyValidationError) ErrorName() string {
+	return "IERCTransaction_ModifyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_ModifyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_Modify.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_ModifyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_ModifyValidationError{}
+
+// Validate checks the field values on IERCTransaction_ClaimAirdrop with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_ClaimAirdrop) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_ClaimAirdrop with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_ClaimAirdropMultiError, or nil if none found.
+func (m *IERCTransaction_ClaimAirdrop) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_ClaimAirdrop) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return IERCTransaction_ClaimAirdropMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_ClaimAirdropMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_ClaimAirdrop.ValidateAll() if
+// the designated constraints aren't met.
+type IERCTransaction_ClaimAirdropMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_ClaimAirdropMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_ClaimAirdropMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_ClaimAirdropValidationError is the validation error returned
+// by IERCTransaction_ClaimAirdrop.Validate if the designated constraints
+// aren't met.
+type IERCTransaction_ClaimAirdropValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_ClaimAirdropValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_ClaimAirdropValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_ClaimAirdropValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_ClaimAirdropValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_ClaimAirdropValidationError) ErrorName() string {
+	return "IERCTransaction_ClaimAirdropValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_ClaimAirdropValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_ClaimAirdrop.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_ClaimAirdropValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_ClaimAirdropValidationError{}
+
+// Validate checks the field values on IERCTransaction_Transfer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_Transfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_Transfer with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_TransferMultiError, or nil if none found.
+func (m *IERCTransaction_Transfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_Transfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransaction_TransferValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransaction_TransferValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransaction_TransferValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IERCTransaction_TransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_TransferMultiError is an error wrapping multiple validation
+// errors returned by IERCTransaction_Transfer.ValidateAll() if the designated
+// constraints aren't met.
+type IERCTransaction_TransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_TransferMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_TransferMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_TransferValidationError is the validation error returned by
+// IERCTransaction_Transfer.Validate if the designated constraints aren't met.
+type IERCTransaction_TransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_TransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_TransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_TransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_TransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_TransferValidationError) ErrorName() string {
+	return "IERCTransaction_TransferValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_TransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_Transfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_TransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_TransferValidationError{}
+
+// Validate checks the field values on IERCTransaction_FreezeSell with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_FreezeSell) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_FreezeSell with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_FreezeSellMultiError, or nil if none found.
+func (m *IERCTransaction_FreezeSell) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_FreezeSell) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransaction_FreezeSellValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransaction_FreezeSellValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransaction_FreezeSellValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IERCTransaction_FreezeSellMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_FreezeSellMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_FreezeSell.ValidateAll() if
+// the designated constraints aren't met.
+type IERCTransaction_FreezeSellMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_FreezeSellMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_FreezeSellMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_FreezeSellValidationError is the validation error returned
+// by IERCTransaction_FreezeSell.Validate if the designated constraints aren't met.
+type IERCTransaction_FreezeSellValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_FreezeSellValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_FreezeSellValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_FreezeSellValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_FreezeSellValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_FreezeSellValidationError) ErrorName() string {
+	return "IERCTransaction_FreezeSellValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_FreezeSellValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_FreezeSell.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_FreezeSellValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_FreezeSellValidationError{}
+
+// Validate checks the field values on IERCTransaction_UnfreezeSell with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_UnfreezeSell) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_UnfreezeSell with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_UnfreezeSellMultiError, or nil if none found.
+func (m *IERCTransaction_UnfreezeSell) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_UnfreezeSell) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransaction_UnfreezeSellValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransaction_UnfreezeSellValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransaction_UnfreezeSellValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IERCTransaction_UnfreezeSellMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_UnfreezeSellMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_UnfreezeSell.ValidateAll() if
+// the designated constraints aren't met.
+type IERCTransaction_UnfreezeSellMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_UnfreezeSellMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_UnfreezeSellMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_UnfreezeSellValidationError is the validation error returned
+// by IERCTransaction_UnfreezeSell.Validate if the designated constraints
+// aren't met.
+type IERCTransaction_UnfreezeSellValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_UnfreezeSellValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_UnfreezeSellValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_UnfreezeSellValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_UnfreezeSellValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_UnfreezeSellValidationError) ErrorName() string {
+	return "IERCTransaction_UnfreezeSellValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_UnfreezeSellValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_UnfreezeSell.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_UnfreezeSellValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_UnfreezeSellValidationError{}
+
+// Validate checks the field values on IERCTransaction_ProxyTransfer with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_ProxyTransfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_ProxyTransfer with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IERCTransaction_ProxyTransferMultiError, or nil if none found.
+func (m *IERCTransaction_ProxyTransfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_ProxyTransfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransaction_ProxyTransferValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransaction_ProxyTransferValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransaction_ProxyTransferValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IERCTransaction_ProxyTransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_ProxyTransferMultiError is an error wrapping multiple
+// validation errors returned by IERCTransaction_ProxyTransfer.ValidateAll()
+// if the designated constraints aren't met.
+type IERCTransaction_ProxyTransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_ProxyTransferMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_ProxyTransferMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_ProxyTransferValidationError is the validation error
+// returned by IERCTransaction_ProxyTransfer.Validate if the designated
+// constraints aren't met.
+type IERCTransaction_ProxyTransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_ProxyTransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_ProxyTransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_ProxyTransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_ProxyTransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_ProxyTransferValidationError) ErrorName() string {
+	return "IERCTransaction_ProxyTransferValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_ProxyTransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_ProxyTransfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_ProxyTransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_ProxyTransferValidationError{}
+
+// Validate checks the field values on IERCTransaction_Staking with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERCTransaction_Staking) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERCTransaction_Staking with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERCTransaction_StakingMultiError, or nil if none found.
+func (m *IERCTransaction_Staking) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERCTransaction_Staking) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pool
+
+	// no validation rules for PoolSubId
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IERCTransaction_StakingValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IERCTransaction_StakingValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IERCTransaction_StakingValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IERCTransaction_StakingMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERCTransaction_StakingMultiError is an error wrapping multiple validation
+// errors returned by IERCTransaction_Staking.ValidateAll() if the designated
+// constraints aren't met.
+type IERCTransaction_StakingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERCTransaction_StakingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERCTransaction_StakingMultiError) AllErrors() []error { return m }
+
+// IERCTransaction_StakingValidationError is the validation error returned by
+// IERCTransaction_Staking.Validate if the designated constraints aren't met.
+type IERCTransaction_StakingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERCTransaction_StakingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERCTransaction_StakingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERCTransaction_StakingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERCTransaction_StakingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERCTransaction_StakingValidationError) ErrorName() string {
+	return "IERCTransaction_StakingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERCTransaction_StakingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERCTransaction_Staking.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERCTransaction_StakingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERCTransaction_StakingValidationError{}
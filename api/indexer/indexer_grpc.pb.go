@@ -24,6 +24,24 @@ const (
 	Indexer_QueryEvents_FullMethodName           = "/api.indexer.Indexer/QueryEvents"
 	Indexer_QuerySystemStatus_FullMethodName     = "/api.indexer.Indexer/QuerySystemStatus"
 	Indexer_CheckTransfer_FullMethodName         = "/api.indexer.Indexer/CheckTransfer"
+	Indexer_GetBalance_FullMethodName            = "/api.indexer.Indexer/GetBalance"
+	Indexer_ListBalancesByAddress_FullMethodName = "/api.indexer.Indexer/ListBalancesByAddress"
+	Indexer_ListHoldersByTick_FullMethodName     = "/api.indexer.Indexer/ListHoldersByTick"
+	Indexer_GetBalanceAt_FullMethodName          = "/api.indexer.Indexer/GetBalanceAt"
+	Indexer_ListBalanceChanges_FullMethodName    = "/api.indexer.Indexer/ListBalanceChanges"
+	Indexer_GetTick_FullMethodName               = "/api.indexer.Indexer/GetTick"
+	Indexer_ListTicks_FullMethodName             = "/api.indexer.Indexer/ListTicks"
+	Indexer_ListStakingPools_FullMethodName      = "/api.indexer.Indexer/ListStakingPools"
+	Indexer_GetStakingPool_FullMethodName        = "/api.indexer.Indexer/GetStakingPool"
+	Indexer_ListStakingPositions_FullMethodName  = "/api.indexer.Indexer/ListStakingPositions"
+	Indexer_GetListing_FullMethodName            = "/api.indexer.Indexer/GetListing"
+	Indexer_ListListings_FullMethodName          = "/api.indexer.Indexer/ListListings"
+	Indexer_GetTickStats_FullMethodName          = "/api.indexer.Indexer/GetTickStats"
+	Indexer_ListTickStats_FullMethodName         = "/api.indexer.Indexer/ListTickStats"
+	Indexer_GetTransaction_FullMethodName        = "/api.indexer.Indexer/GetTransaction"
+	Indexer_CreateWebhook_FullMethodName         = "/api.indexer.Indexer/CreateWebhook"
+	Indexer_ListWebhooks_FullMethodName          = "/api.indexer.Indexer/ListWebhooks"
+	Indexer_DeleteWebhook_FullMethodName         = "/api.indexer.Indexer/DeleteWebhook"
 )
 
 // IndexerClient is the client API for Indexer service.
@@ -35,6 +53,26 @@ type IndexerClient interface {
 	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsReply, error)
 	QuerySystemStatus(ctx context.Context, in *QuerySystemStatusRequest, opts ...grpc.CallOption) (*QuerySystemStatusReply, error)
 	CheckTransfer(ctx context.Context, in *CheckTransferRequest, opts ...grpc.CallOption) (*CheckTransferReply, error)
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceReply, error)
+	ListBalancesByAddress(ctx context.Context, in *ListBalancesByAddressRequest, opts ...grpc.CallOption) (*ListBalancesByAddressReply, error)
+	ListHoldersByTick(ctx context.Context, in *ListHoldersByTickRequest, opts ...grpc.CallOption) (*ListHoldersByTickReply, error)
+	GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtReply, error)
+	ListBalanceChanges(ctx context.Context, in *ListBalanceChangesRequest, opts ...grpc.CallOption) (*ListBalanceChangesReply, error)
+	GetTick(ctx context.Context, in *GetTickRequest, opts ...grpc.CallOption) (*GetTickReply, error)
+	ListTicks(ctx context.Context, in *ListTicksRequest, opts ...grpc.CallOption) (*ListTicksReply, error)
+	ListStakingPools(ctx context.Context, in *ListStakingPoolsRequest, opts ...grpc.CallOption) (*ListStakingPoolsReply, error)
+	GetStakingPool(ctx context.Context, in *GetStakingPoolRequest, opts ...grpc.CallOption) (*GetStakingPoolReply, error)
+	ListStakingPositions(ctx context.Context, in *ListStakingPositionsRequest, opts ...grpc.CallOption) (*ListStakingPositionsReply, error)
+	GetListing(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*GetListingReply, error)
+	ListListings(ctx context.Context, in *ListListingsRequest, opts ...grpc.CallOption) (*ListListingsReply, error)
+	GetTickStats(ctx context.Context, in *GetTickStatsRequest, opts ...grpc.CallOption) (*GetTickStatsReply, error)
+	ListTickStats(ctx context.Context, in *ListTickStatsRequest, opts ...grpc.CallOption) (*ListTickStatsReply, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionReply, error)
+	// the webhook rpcs are admin rpcs, they require the bearer admin token and are unimplemented
+	// when the webhooks are disabled.
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookReply, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookReply, error)
 }
 
 type indexerClient struct {
@@ -136,6 +174,168 @@ func (c *indexerClient) CheckTransfer(ctx context.Context, in *CheckTransferRequ
 	return out, nil
 }
 
+func (c *indexerClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceReply, error) {
+	out := new(GetBalanceReply)
+	err := c.cc.Invoke(ctx, Indexer_GetBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListBalancesByAddress(ctx context.Context, in *ListBalancesByAddressRequest, opts ...grpc.CallOption) (*ListBalancesByAddressReply, error) {
+	out := new(ListBalancesByAddressReply)
+	err := c.cc.Invoke(ctx, Indexer_ListBalancesByAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListHoldersByTick(ctx context.Context, in *ListHoldersByTickRequest, opts ...grpc.CallOption) (*ListHoldersByTickReply, error) {
+	out := new(ListHoldersByTickReply)
+	err := c.cc.Invoke(ctx, Indexer_ListHoldersByTick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtReply, error) {
+	out := new(GetBalanceAtReply)
+	err := c.cc.Invoke(ctx, Indexer_GetBalanceAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListBalanceChanges(ctx context.Context, in *ListBalanceChangesRequest, opts ...grpc.CallOption) (*ListBalanceChangesReply, error) {
+	out := new(ListBalanceChangesReply)
+	err := c.cc.Invoke(ctx, Indexer_ListBalanceChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetTick(ctx context.Context, in *GetTickRequest, opts ...grpc.CallOption) (*GetTickReply, error) {
+	out := new(GetTickReply)
+	err := c.cc.Invoke(ctx, Indexer_GetTick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListTicks(ctx context.Context, in *ListTicksRequest, opts ...grpc.CallOption) (*ListTicksReply, error) {
+	out := new(ListTicksReply)
+	err := c.cc.Invoke(ctx, Indexer_ListTicks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListStakingPools(ctx context.Context, in *ListStakingPoolsRequest, opts ...grpc.CallOption) (*ListStakingPoolsReply, error) {
+	out := new(ListStakingPoolsReply)
+	err := c.cc.Invoke(ctx, Indexer_ListStakingPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetStakingPool(ctx context.Context, in *GetStakingPoolRequest, opts ...grpc.CallOption) (*GetStakingPoolReply, error) {
+	out := new(GetStakingPoolReply)
+	err := c.cc.Invoke(ctx, Indexer_GetStakingPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListStakingPositions(ctx context.Context, in *ListStakingPositionsRequest, opts ...grpc.CallOption) (*ListStakingPositionsReply, error) {
+	out := new(ListStakingPositionsReply)
+	err := c.cc.Invoke(ctx, Indexer_ListStakingPositions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetListing(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*GetListingReply, error) {
+	out := new(GetListingReply)
+	err := c.cc.Invoke(ctx, Indexer_GetListing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListListings(ctx context.Context, in *ListListingsRequest, opts ...grpc.CallOption) (*ListListingsReply, error) {
+	out := new(ListListingsReply)
+	err := c.cc.Invoke(ctx, Indexer_ListListings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetTickStats(ctx context.Context, in *GetTickStatsRequest, opts ...grpc.CallOption) (*GetTickStatsReply, error) {
+	out := new(GetTickStatsReply)
+	err := c.cc.Invoke(ctx, Indexer_GetTickStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListTickStats(ctx context.Context, in *ListTickStatsRequest, opts ...grpc.CallOption) (*ListTickStatsReply, error) {
+	out := new(ListTickStatsReply)
+	err := c.cc.Invoke(ctx, Indexer_ListTickStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionReply, error) {
+	out := new(GetTransactionReply)
+	err := c.cc.Invoke(ctx, Indexer_GetTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookReply, error) {
+	out := new(CreateWebhookReply)
+	err := c.cc.Invoke(ctx, Indexer_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error) {
+	out := new(ListWebhooksReply)
+	err := c.cc.Invoke(ctx, Indexer_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookReply, error) {
+	out := new(DeleteWebhookReply)
+	err := c.cc.Invoke(ctx, Indexer_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexerServer is the server API for Indexer service.
 // All implementations must embed UnimplementedIndexerServer
 // for forward compatibility
@@ -145,6 +345,26 @@ type IndexerServer interface {
 	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsReply, error)
 	QuerySystemStatus(context.Context, *QuerySystemStatusRequest) (*QuerySystemStatusReply, error)
 	CheckTransfer(context.Context, *CheckTransferRequest) (*CheckTransferReply, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceReply, error)
+	ListBalancesByAddress(context.Context, *ListBalancesByAddressRequest) (*ListBalancesByAddressReply, error)
+	ListHoldersByTick(context.Context, *ListHoldersByTickRequest) (*ListHoldersByTickReply, error)
+	GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtReply, error)
+	ListBalanceChanges(context.Context, *ListBalanceChangesRequest) (*ListBalanceChangesReply, error)
+	GetTick(context.Context, *GetTickRequest) (*GetTickReply, error)
+	ListTicks(context.Context, *ListTicksRequest) (*ListTicksReply, error)
+	ListStakingPools(context.Context, *ListStakingPoolsRequest) (*ListStakingPoolsReply, error)
+	GetStakingPool(context.Context, *GetStakingPoolRequest) (*GetStakingPoolReply, error)
+	ListStakingPositions(context.Context, *ListStakingPositionsRequest) (*ListStakingPositionsReply, error)
+	GetListing(context.Context, *GetListingRequest) (*GetListingReply, error)
+	ListListings(context.Context, *ListListingsRequest) (*ListListingsReply, error)
+	GetTickStats(context.Context, *GetTickStatsRequest) (*GetTickStatsReply, error)
+	ListTickStats(context.Context, *ListTickStatsRequest) (*ListTickStatsReply, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionReply, error)
+	// the webhook rpcs are admin rpcs, they require the bearer admin token and are unimplemented
+	// when the webhooks are disabled.
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error)
 	mustEmbedUnimplementedIndexerServer()
 }
 
@@ -167,6 +387,60 @@ func (UnimplementedIndexerServer) QuerySystemStatus(context.Context, *QuerySyste
 func (UnimplementedIndexerServer) CheckTransfer(context.Context, *CheckTransferRequest) (*CheckTransferReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckTransfer not implemented")
 }
+func (UnimplementedIndexerServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedIndexerServer) ListBalancesByAddress(context.Context, *ListBalancesByAddressRequest) (*ListBalancesByAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalancesByAddress not implemented")
+}
+func (UnimplementedIndexerServer) ListHoldersByTick(context.Context, *ListHoldersByTickRequest) (*ListHoldersByTickReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHoldersByTick not implemented")
+}
+func (UnimplementedIndexerServer) GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceAt not implemented")
+}
+func (UnimplementedIndexerServer) ListBalanceChanges(context.Context, *ListBalanceChangesRequest) (*ListBalanceChangesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalanceChanges not implemented")
+}
+func (UnimplementedIndexerServer) GetTick(context.Context, *GetTickRequest) (*GetTickReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTick not implemented")
+}
+func (UnimplementedIndexerServer) ListTicks(context.Context, *ListTicksRequest) (*ListTicksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicks not implemented")
+}
+func (UnimplementedIndexerServer) ListStakingPools(context.Context, *ListStakingPoolsRequest) (*ListStakingPoolsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStakingPools not implemented")
+}
+func (UnimplementedIndexerServer) GetStakingPool(context.Context, *GetStakingPoolRequest) (*GetStakingPoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakingPool not implemented")
+}
+func (UnimplementedIndexerServer) ListStakingPositions(context.Context, *ListStakingPositionsRequest) (*ListStakingPositionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStakingPositions not implemented")
+}
+func (UnimplementedIndexerServer) GetListing(context.Context, *GetListingRequest) (*GetListingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListing not implemented")
+}
+func (UnimplementedIndexerServer) ListListings(context.Context, *ListListingsRequest) (*ListListingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListListings not implemented")
+}
+func (UnimplementedIndexerServer) GetTickStats(context.Context, *GetTickStatsRequest) (*GetTickStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickStats not implemented")
+}
+func (UnimplementedIndexerServer) ListTickStats(context.Context, *ListTickStatsRequest) (*ListTickStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickStats not implemented")
+}
+func (UnimplementedIndexerServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedIndexerServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedIndexerServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedIndexerServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
 func (UnimplementedIndexerServer) mustEmbedUnimplementedIndexerServer() {}
 
 // UnsafeIndexerServer may be embedded to opt out of forward compatibility for this service.
@@ -276,6 +550,330 @@ func _Indexer_CheckTransfer_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Indexer_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListBalancesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalancesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListBalancesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListBalancesByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListBalancesByAddress(ctx, req.(*ListBalancesByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListHoldersByTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHoldersByTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListHoldersByTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListHoldersByTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListHoldersByTick(ctx, req.(*ListHoldersByTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetBalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetBalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetBalanceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetBalanceAt(ctx, req.(*GetBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListBalanceChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalanceChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListBalanceChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListBalanceChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListBalanceChanges(ctx, req.(*ListBalanceChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetTick(ctx, req.(*GetTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListTicks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListTicks(ctx, req.(*ListTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListStakingPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStakingPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListStakingPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListStakingPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListStakingPools(ctx, req.(*ListStakingPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetStakingPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakingPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetStakingPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetStakingPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetStakingPool(ctx, req.(*GetStakingPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListStakingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStakingPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListStakingPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListStakingPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListStakingPositions(ctx, req.(*ListStakingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetListing(ctx, req.(*GetListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListListings(ctx, req.(*ListListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetTickStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetTickStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetTickStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetTickStats(ctx, req.(*GetTickStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListTickStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTickStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListTickStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListTickStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListTickStats(ctx, req.(*ListTickStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Indexer_ServiceDesc is the grpc.ServiceDesc for Indexer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -295,6 +893,78 @@ var Indexer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckTransfer",
 			Handler:    _Indexer_CheckTransfer_Handler,
 		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _Indexer_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListBalancesByAddress",
+			Handler:    _Indexer_ListBalancesByAddress_Handler,
+		},
+		{
+			MethodName: "ListHoldersByTick",
+			Handler:    _Indexer_ListHoldersByTick_Handler,
+		},
+		{
+			MethodName: "GetBalanceAt",
+			Handler:    _Indexer_GetBalanceAt_Handler,
+		},
+		{
+			MethodName: "ListBalanceChanges",
+			Handler:    _Indexer_ListBalanceChanges_Handler,
+		},
+		{
+			MethodName: "GetTick",
+			Handler:    _Indexer_GetTick_Handler,
+		},
+		{
+			MethodName: "ListTicks",
+			Handler:    _Indexer_ListTicks_Handler,
+		},
+		{
+			MethodName: "ListStakingPools",
+			Handler:    _Indexer_ListStakingPools_Handler,
+		},
+		{
+			MethodName: "GetStakingPool",
+			Handler:    _Indexer_GetStakingPool_Handler,
+		},
+		{
+			MethodName: "ListStakingPositions",
+			Handler:    _Indexer_ListStakingPositions_Handler,
+		},
+		{
+			MethodName: "GetListing",
+			Handler:    _Indexer_GetListing_Handler,
+		},
+		{
+			MethodName: "ListListings",
+			Handler:    _Indexer_ListListings_Handler,
+		},
+		{
+			MethodName: "GetTickStats",
+			Handler:    _Indexer_GetTickStats_Handler,
+		},
+		{
+			MethodName: "ListTickStats",
+			Handler:    _Indexer_ListTickStats_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _Indexer_GetTransaction_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Indexer_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Indexer_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Indexer_DeleteWebhook_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -20,11 +20,47 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationIndexerCheckTransfer = "/api.indexer.Indexer/CheckTransfer"
+const OperationIndexerCreateWebhook = "/api.indexer.Indexer/CreateWebhook"
+const OperationIndexerDeleteWebhook = "/api.indexer.Indexer/DeleteWebhook"
+const OperationIndexerGetBalance = "/api.indexer.Indexer/GetBalance"
+const OperationIndexerGetBalanceAt = "/api.indexer.Indexer/GetBalanceAt"
+const OperationIndexerGetListing = "/api.indexer.Indexer/GetListing"
+const OperationIndexerGetStakingPool = "/api.indexer.Indexer/GetStakingPool"
+const OperationIndexerGetTick = "/api.indexer.Indexer/GetTick"
+const OperationIndexerGetTickStats = "/api.indexer.Indexer/GetTickStats"
+const OperationIndexerGetTransaction = "/api.indexer.Indexer/GetTransaction"
+const OperationIndexerListBalanceChanges = "/api.indexer.Indexer/ListBalanceChanges"
+const OperationIndexerListBalancesByAddress = "/api.indexer.Indexer/ListBalancesByAddress"
+const OperationIndexerListHoldersByTick = "/api.indexer.Indexer/ListHoldersByTick"
+const OperationIndexerListListings = "/api.indexer.Indexer/ListListings"
+const OperationIndexerListStakingPools = "/api.indexer.Indexer/ListStakingPools"
+const OperationIndexerListStakingPositions = "/api.indexer.Indexer/ListStakingPositions"
+const OperationIndexerListTickStats = "/api.indexer.Indexer/ListTickStats"
+const OperationIndexerListTicks = "/api.indexer.Indexer/ListTicks"
+const OperationIndexerListWebhooks = "/api.indexer.Indexer/ListWebhooks"
 const OperationIndexerQueryEvents = "/api.indexer.Indexer/QueryEvents"
 const OperationIndexerQuerySystemStatus = "/api.indexer.Indexer/QuerySystemStatus"
 
 type IndexerHTTPServer interface {
 	CheckTransfer(context.Context, *CheckTransferRequest) (*CheckTransferReply, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceReply, error)
+	GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtReply, error)
+	GetListing(context.Context, *GetListingRequest) (*GetListingReply, error)
+	GetStakingPool(context.Context, *GetStakingPoolRequest) (*GetStakingPoolReply, error)
+	GetTick(context.Context, *GetTickRequest) (*GetTickReply, error)
+	GetTickStats(context.Context, *GetTickStatsRequest) (*GetTickStatsReply, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionReply, error)
+	ListBalanceChanges(context.Context, *ListBalanceChangesRequest) (*ListBalanceChangesReply, error)
+	ListBalancesByAddress(context.Context, *ListBalancesByAddressRequest) (*ListBalancesByAddressReply, error)
+	ListHoldersByTick(context.Context, *ListHoldersByTickRequest) (*ListHoldersByTickReply, error)
+	ListListings(context.Context, *ListListingsRequest) (*ListListingsReply, error)
+	ListStakingPools(context.Context, *ListStakingPoolsRequest) (*ListStakingPoolsReply, error)
+	ListStakingPositions(context.Context, *ListStakingPositionsRequest) (*ListStakingPositionsReply, error)
+	ListTickStats(context.Context, *ListTickStatsRequest) (*ListTickStatsReply, error)
+	ListTicks(context.Context, *ListTicksRequest) (*ListTicksReply, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error)
 	// QueryEvents
 	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsReply, error)
 	// QuerySystemStatus
@@ -36,6 +72,24 @@ func RegisterIndexerHTTPServer(s *http.Server, srv IndexerHTTPServer) {
 	r.GET("/api/v2/index/events", _Indexer_QueryEvents0_HTTP_Handler(srv))
 	r.GET("/api/v2/index/status", _Indexer_QuerySystemStatus0_HTTP_Handler(srv))
 	r.GET("/api/v2/index/check_transfer", _Indexer_CheckTransfer0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/balance", _Indexer_GetBalance0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/balances", _Indexer_ListBalancesByAddress0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/holders", _Indexer_ListHoldersByTick0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/balance/at", _Indexer_GetBalanceAt0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/balance/changes", _Indexer_ListBalanceChanges0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/tick", _Indexer_GetTick0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/ticks", _Indexer_ListTicks0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/staking/pools", _Indexer_ListStakingPools0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/staking/pool", _Indexer_GetStakingPool0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/staking/positions", _Indexer_ListStakingPositions0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/market/listing", _Indexer_GetListing0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/market/listings", _Indexer_ListListings0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/market/stats", _Indexer_GetTickStats0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/market/stats/history", _Indexer_ListTickStats0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/transaction", _Indexer_GetTransaction0_HTTP_Handler(srv))
+	r.POST("/api/v2/index/webhooks", _Indexer_CreateWebhook0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/webhooks", _Indexer_ListWebhooks0_HTTP_Handler(srv))
+	r.DELETE("/api/v2/index/webhooks", _Indexer_DeleteWebhook0_HTTP_Handler(srv))
 }
 
 func _Indexer_QueryEvents0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
@@ -95,8 +149,371 @@ func _Indexer_CheckTransfer0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.C
 	}
 }
 
+func _Indexer_GetBalance0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBalanceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetBalance)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBalance(ctx, req.(*GetBalanceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBalanceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListBalancesByAddress0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBalancesByAddressRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListBalancesByAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBalancesByAddress(ctx, req.(*ListBalancesByAddressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBalancesByAddressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListHoldersByTick0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListHoldersByTickRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListHoldersByTick)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListHoldersByTick(ctx, req.(*ListHoldersByTickRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListHoldersByTickReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetBalanceAt0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBalanceAtRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetBalanceAt)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBalanceAt(ctx, req.(*GetBalanceAtRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBalanceAtReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListBalanceChanges0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBalanceChangesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListBalanceChanges)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBalanceChanges(ctx, req.(*ListBalanceChangesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBalanceChangesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetTick0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTickRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetTick)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTick(ctx, req.(*GetTickRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTickReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListTicks0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTicksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListTicks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTicks(ctx, req.(*ListTicksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTicksReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListStakingPools0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStakingPoolsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListStakingPools)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStakingPools(ctx, req.(*ListStakingPoolsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStakingPoolsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetStakingPool0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetStakingPoolRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetStakingPool)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetStakingPool(ctx, req.(*GetStakingPoolRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetStakingPoolReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListStakingPositions0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStakingPositionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListStakingPositions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStakingPositions(ctx, req.(*ListStakingPositionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStakingPositionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetListing0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetListingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetListing)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetListing(ctx, req.(*GetListingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetListingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListListings0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListListingsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListListings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListListings(ctx, req.(*ListListingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListListingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetTickStats0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTickStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetTickStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTickStats(ctx, req.(*GetTickStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTickStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListTickStats0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTickStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListTickStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTickStats(ctx, req.(*ListTickStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTickStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetTransaction0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTransactionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetTransaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTransaction(ctx, req.(*GetTransactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTransactionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_CreateWebhook0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateWebhookRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerCreateWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateWebhook(ctx, req.(*CreateWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateWebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListWebhooks0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhooksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListWebhooks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhooks(ctx, req.(*ListWebhooksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhooksReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_DeleteWebhook0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteWebhookRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerDeleteWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteWebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type IndexerHTTPClient interface {
 	CheckTransfer(ctx context.Context, req *CheckTransferRequest, opts ...http.CallOption) (rsp *CheckTransferReply, err error)
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest, opts ...http.CallOption) (rsp *CreateWebhookReply, err error)
+	DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest, opts ...http.CallOption) (rsp *DeleteWebhookReply, err error)
+	GetBalance(ctx context.Context, req *GetBalanceRequest, opts ...http.CallOption) (rsp *GetBalanceReply, err error)
+	GetBalanceAt(ctx context.Context, req *GetBalanceAtRequest, opts ...http.CallOption) (rsp *GetBalanceAtReply, err error)
+	GetListing(ctx context.Context, req *GetListingRequest, opts ...http.CallOption) (rsp *GetListingReply, err error)
+	GetStakingPool(ctx context.Context, req *GetStakingPoolRequest, opts ...http.CallOption) (rsp *GetStakingPoolReply, err error)
+	GetTick(ctx context.Context, req *GetTickRequest, opts ...http.CallOption) (rsp *GetTickReply, err error)
+	GetTickStats(ctx context.Context, req *GetTickStatsRequest, opts ...http.CallOption) (rsp *GetTickStatsReply, err error)
+	GetTransaction(ctx context.Context, req *GetTransactionRequest, opts ...http.CallOption) (rsp *GetTransactionReply, err error)
+	ListBalanceChanges(ctx context.Context, req *ListBalanceChangesRequest, opts ...http.CallOption) (rsp *ListBalanceChangesReply, err error)
+	ListBalancesByAddress(ctx context.Context, req *ListBalancesByAddressRequest, opts ...http.CallOption) (rsp *ListBalancesByAddressReply, err error)
+	ListHoldersByTick(ctx context.Context, req *ListHoldersByTickRequest, opts ...http.CallOption) (rsp *ListHoldersByTickReply, err error)
+	ListListings(ctx context.Context, req *ListListingsRequest, opts ...http.CallOption) (rsp *ListListingsReply, err error)
+	ListStakingPools(ctx context.Context, req *ListStakingPoolsRequest, opts ...http.CallOption) (rsp *ListStakingPoolsReply, err error)
+	ListStakingPositions(ctx context.Context, req *ListStakingPositionsRequest, opts ...http.CallOption) (rsp *ListStakingPositionsReply, err error)
+	ListTickStats(ctx context.Context, req *ListTickStatsRequest, opts ...http.CallOption) (rsp *ListTickStatsReply, err error)
+	ListTicks(ctx context.Context, req *ListTicksRequest, opts ...http.CallOption) (rsp *ListTicksReply, err error)
+	ListWebhooks(ctx context.Context, req *ListWebhooksRequest, opts ...http.CallOption) (rsp *ListWebhooksReply, err error)
 	QueryEvents(ctx context.Context, req *QueryEventsRequest, opts ...http.CallOption) (rsp *QueryEventsReply, err error)
 	QuerySystemStatus(ctx context.Context, req *QuerySystemStatusRequest, opts ...http.CallOption) (rsp *QuerySystemStatusReply, err error)
 }
@@ -122,6 +539,240 @@ func (c *IndexerHTTPClientImpl) CheckTransfer(ctx context.Context, in *CheckTran
 	return &out, err
 }
 
+func (c *IndexerHTTPClientImpl) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...http.CallOption) (*CreateWebhookReply, error) {
+	var out CreateWebhookReply
+	pattern := "/api/v2/index/webhooks"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationIndexerCreateWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...http.CallOption) (*DeleteWebhookReply, error) {
+	var out DeleteWebhookReply
+	pattern := "/api/v2/index/webhooks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerDeleteWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...http.CallOption) (*GetBalanceReply, error) {
+	var out GetBalanceReply
+	pattern := "/api/v2/index/balance"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetBalance))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...http.CallOption) (*GetBalanceAtReply, error) {
+	var out GetBalanceAtReply
+	pattern := "/api/v2/index/balance/at"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetBalanceAt))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetListing(ctx context.Context, in *GetListingRequest, opts ...http.CallOption) (*GetListingReply, error) {
+	var out GetListingReply
+	pattern := "/api/v2/index/market/listing"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetListing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetStakingPool(ctx context.Context, in *GetStakingPoolRequest, opts ...http.CallOption) (*GetStakingPoolReply, error) {
+	var out GetStakingPoolReply
+	pattern := "/api/v2/index/staking/pool"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetStakingPool))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetTick(ctx context.Context, in *GetTickRequest, opts ...http.CallOption) (*GetTickReply, error) {
+	var out GetTickReply
+	pattern := "/api/v2/index/tick"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetTick))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetTickStats(ctx context.Context, in *GetTickStatsRequest, opts ...http.CallOption) (*GetTickStatsReply, error) {
+	var out GetTickStatsReply
+	pattern := "/api/v2/index/market/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetTickStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...http.CallOption) (*GetTransactionReply, error) {
+	var out GetTransactionReply
+	pattern := "/api/v2/index/transaction"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetTransaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListBalanceChanges(ctx context.Context, in *ListBalanceChangesRequest, opts ...http.CallOption) (*ListBalanceChangesReply, error) {
+	var out ListBalanceChangesReply
+	pattern := "/api/v2/index/balance/changes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListBalanceChanges))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListBalancesByAddress(ctx context.Context, in *ListBalancesByAddressRequest, opts ...http.CallOption) (*ListBalancesByAddressReply, error) {
+	var out ListBalancesByAddressReply
+	pattern := "/api/v2/index/balances"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListBalancesByAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListHoldersByTick(ctx context.Context, in *ListHoldersByTickRequest, opts ...http.CallOption) (*ListHoldersByTickReply, error) {
+	var out ListHoldersByTickReply
+	pattern := "/api/v2/index/holders"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListHoldersByTick))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListListings(ctx context.Context, in *ListListingsRequest, opts ...http.CallOption) (*ListListingsReply, error) {
+	var out ListListingsReply
+	pattern := "/api/v2/index/market/listings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListListings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListStakingPools(ctx context.Context, in *ListStakingPoolsRequest, opts ...http.CallOption) (*ListStakingPoolsReply, error) {
+	var out ListStakingPoolsReply
+	pattern := "/api/v2/index/staking/pools"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListStakingPools))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListStakingPositions(ctx context.Context, in *ListStakingPositionsRequest, opts ...http.CallOption) (*ListStakingPositionsReply, error) {
+	var out ListStakingPositionsReply
+	pattern := "/api/v2/index/staking/positions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListStakingPositions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListTickStats(ctx context.Context, in *ListTickStatsRequest, opts ...http.CallOption) (*ListTickStatsReply, error) {
+	var out ListTickStatsReply
+	pattern := "/api/v2/index/market/stats/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListTickStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListTicks(ctx context.Context, in *ListTicksRequest, opts ...http.CallOption) (*ListTicksReply, error) {
+	var out ListTicksReply
+	pattern := "/api/v2/index/ticks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListTicks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...http.CallOption) (*ListWebhooksReply, error) {
+	var out ListWebhooksReply
+	pattern := "/api/v2/index/webhooks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListWebhooks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *IndexerHTTPClientImpl) QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...http.CallOption) (*QueryEventsReply, error) {
 	var out QueryEventsReply
 	pattern := "/api/v2/index/events"
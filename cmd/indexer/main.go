@@ -5,7 +5,9 @@ import (
 	"os"
 
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/service"
+	"github.com/IErcOrg/IERC_Indexer/internal/facade"
 	"github.com/IErcOrg/IERC_Indexer/internal/facade/handler"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
@@ -29,14 +31,16 @@ func init() {
 	flag.StringVar(&flagconf, "c", "../../configs", "config path, eg: -c config.yaml")
 }
 
-func newApp(logger log.Logger, ss *service.IndexDomainService, rh *handler.IndexHandler, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, mg *repository.Migrator, ss *service.IndexDomainService, rh *handler.IndexHandler, gs *grpc.Server, hs *http.Server, wd *facade.WebhookDispatcher, or *service.OutboxRelay) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
 		kratos.Metadata(map[string]string{}),
 		kratos.Logger(logger),
-		kratos.Server(ss, rh, gs, hs),
+		// the projections added over an indexed database are seeded before the blocks are handled.
+		kratos.BeforeStart(mg.Migrate),
+		kratos.Server(ss, rh, gs, hs, wd, or),
 	)
 }
 
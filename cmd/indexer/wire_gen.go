@@ -14,7 +14,6 @@ import (
 	"github.com/IErcOrg/IERC_Indexer/internal/facade/handler"
 	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository"
 	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql"
-	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/network/ethereum"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -31,14 +30,16 @@ func wireApp(string2 string, logger log.Logger) (*kratos.App, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	parserParser := parser.NewParser()
-	blockFetcher, err := ethereum.NewEthereumFetcher(config, parserParser, logger)
+	db, cleanup2, err := repository.NewDB(config, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	db, cleanup2, err := repository.NewDB(config, logger)
+	migrator := repository.NewMigrator(db, logger)
+	parserParser := parser.NewParser()
+	blockFetcher, err := repository.NewBlockFetcher(config, parserParser, logger)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
@@ -52,6 +53,7 @@ func wireApp(string2 string, logger log.Logger) (*kratos.App, func(), error) {
 	}
 	data := repository.NewData(db, bigCache)
 	transactionRepository := repository.NewTransactionRepository(data)
+	journalRepository := mysqlimpl.NewJournalRepository(db)
 	tickRepository := repository.NewTickRepository(db, bigCache)
 	balanceRepository := repository.NewBalanceRepository(db, bigCache)
 	stakingRepository, err := repository.NewStakingRepository(db)
@@ -61,19 +63,39 @@ func wireApp(string2 string, logger log.Logger) (*kratos.App, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	blockService, err := service.NewBlockService(config, logger, blockRepository, eventRepository, transactionRepository, tickRepository, balanceRepository, stakingRepository)
+	outboxRepository := repository.NewOutboxRepository(config, db)
+	listingRepository := mysqlimpl.NewListingRepository(db)
+	statsRepository := mysqlimpl.NewStatsRepository(db)
+	blockService, err := service.NewBlockService(config, logger, blockRepository, eventRepository, transactionRepository, journalRepository, tickRepository, balanceRepository, stakingRepository, outboxRepository, listingRepository, statsRepository)
 	if err != nil {
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	indexDomainService := service.NewIndexApplication(config, logger, blockFetcher, blockRepository, blockService)
-	indexHandler := handler.NewIndexHandler(indexDomainService, eventRepository, blockFetcher, blockRepository, logger)
+	indexDomainService, err := service.NewIndexApplication(config, logger, blockFetcher, blockRepository, blockService)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhookRepository := mysqlimpl.NewWebhookRepository(db)
+	indexHandler := handler.NewIndexHandler(config, indexDomainService, eventRepository, blockFetcher, blockRepository, balanceRepository, tickRepository, stakingRepository, webhookRepository, listingRepository, statsRepository, logger)
 	server := facade.NewGRPCServer(config, indexHandler, logger)
 	httpServer := facade.NewHTTPServer(config, indexHandler, logger)
-	app := newApp(logger, indexDomainService, indexHandler, server, httpServer)
+	webhookDispatcher := facade.NewWebhookDispatcher(config, eventRepository, webhookRepository, logger)
+	broker, cleanup4, err := repository.NewBroker(config, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outboxRelay := service.NewOutboxRelay(outboxRepository, broker, logger)
+	app := newApp(logger, migrator, indexDomainService, indexHandler, server, httpServer, webhookDispatcher, outboxRelay)
 	return app, func() {
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
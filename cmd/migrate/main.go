@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// flagconf is the config flag.
+	flagconf string
+	// list only prints the pending migrations.
+	list bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "c", "../../configs", "config path, eg: -c config.yaml")
+	flag.BoolVar(&list, "list", false, "print the pending migrations without running them")
+}
+
+// Seed the projections added over an indexed database. The indexer runs the pending migrations
+// when it starts, run them ahead to keep the replay out of the upgrade window.
+func main() {
+	flag.Parse()
+	logger := log.With(log.NewStdLogger(os.Stdout),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+	)
+
+	log.SetLogger(logger)
+	helper := log.NewHelper(logger)
+
+	migrator, cleanup, err := wireMigrator(flagconf, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	if list {
+		helper.Infof("pending migrations: %v", pending)
+		return
+	}
+
+	if err := migrator.Migrate(ctx); err != nil {
+		panic(err)
+	}
+
+	helper.Infof("migrate done. migrations: %v", pending)
+}
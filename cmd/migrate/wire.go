@@ -0,0 +1,21 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireMigrator init migrator.
+func wireMigrator(string, log.Logger) (*repository.Migrator, func(), error) {
+	panic(wire.Build(
+		conf.ProviderSet,
+		repository.ProviderSet,
+	))
+}
@@ -0,0 +1,33 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Injectors from wire.go:
+
+// wireMigrator init migrator.
+func wireMigrator(string2 string, logger log.Logger) (*repository.Migrator, func(), error) {
+	config, cleanup, err := conf.NewConfigFromPath(string2, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup2, err := repository.NewDB(config, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	migrator := repository.NewMigrator(db, logger)
+	return migrator, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
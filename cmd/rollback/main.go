@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// flagconf is the config flag.
+	flagconf string
+	// height is the block height to roll back to.
+	height uint64
+)
+
+func init() {
+	flag.StringVar(&flagconf, "c", "../../configs", "config path, eg: -c config.yaml")
+	flag.Uint64Var(&height, "height", 0, "roll back the indexed state to the block height, eg: -height 19000000")
+}
+
+// Roll back the indexed state to a block height. The indexer must be stopped before running it,
+// the blocks above the height are marked as unprocessed and handled again on the next start.
+func main() {
+	flag.Parse()
+	logger := log.With(log.NewStdLogger(os.Stdout),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+	)
+
+	log.SetLogger(logger)
+	helper := log.NewHelper(logger)
+
+	if height == 0 {
+		helper.Fatal("missing block height")
+	}
+
+	srv, cleanup, err := wireBlockService(flagconf, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	if err := srv.RollbackToHeight(context.Background(), height); err != nil {
+		panic(err)
+	}
+
+	helper.Infof("rollback done. height: %d", height)
+}
@@ -0,0 +1,23 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/service"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireBlockService init block service.
+func wireBlockService(string, log.Logger) (*service.BlockService, func(), error) {
+	panic(wire.Build(
+		conf.ProviderSet,
+		repository.ProviderSet,
+		service.ProviderSet,
+	))
+}
@@ -0,0 +1,67 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol/parser"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/service"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Injectors from wire.go:
+
+// wireBlockService init block service.
+func wireBlockService(string2 string, logger log.Logger) (*service.BlockService, func(), error) {
+	config, cleanup, err := conf.NewConfigFromPath(string2, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup2, err := repository.NewDB(config, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	parserParser := parser.NewParser()
+	blockRepository := mysqlimpl.NewBlockRepo(db, parserParser)
+	eventRepository := mysqlimpl.NewEventRepository(db)
+	bigCache, cleanup3, err := repository.NewCache()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	data := repository.NewData(db, bigCache)
+	transactionRepository := repository.NewTransactionRepository(data)
+	journalRepository := mysqlimpl.NewJournalRepository(db)
+	tickRepository := repository.NewTickRepository(db, bigCache)
+	balanceRepository := repository.NewBalanceRepository(db, bigCache)
+	stakingRepository, err := repository.NewStakingRepository(db)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outboxRepository := repository.NewOutboxRepository(config, db)
+	listingRepository := mysqlimpl.NewListingRepository(db)
+	statsRepository := mysqlimpl.NewStatsRepository(db)
+	blockService, err := service.NewBlockService(config, logger, blockRepository, eventRepository, transactionRepository, journalRepository, tickRepository, balanceRepository, stakingRepository, outboxRepository, listingRepository, statsRepository)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	return blockService, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/service"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// flagconf is the config flag.
+	flagconf string
+	// tick is the tick to snapshot.
+	tick string
+	// height is the block height of the snapshot, 0 for the last handled block.
+	height uint64
+	// format is the output format, csv or json.
+	format string
+	// output is the output file, stdout if empty.
+	output string
+	// minTotal is the minimum total amount of a holder.
+	minTotal string
+	// exclude is the comma separated addresses left out of the snapshot.
+	exclude string
+	// excludeFile is a file of addresses left out of the snapshot, one per line.
+	excludeFile string
+)
+
+type snapshotApp struct {
+	Migrator *repository.Migrator
+	Service  *service.SnapshotService
+}
+
+func init() {
+	flag.StringVar(&flagconf, "c", "../../configs", "config path, eg: -c config.yaml")
+	flag.StringVar(&tick, "tick", "", "the tick to snapshot, eg: -tick ethi")
+	flag.Uint64Var(&height, "height", 0, "the block height of the snapshot, the last handled block if 0, eg: -height 19000000")
+	flag.StringVar(&format, "format", "csv", "the output format, csv or json")
+	flag.StringVar(&output, "o", "", "the output file, stdout if empty")
+	flag.StringVar(&minTotal, "min", "0", "the minimum total amount of a holder, eg: -min 1000")
+	flag.StringVar(&exclude, "exclude", protocol.ZeroAddress+","+protocol.PlatformAddress, "comma separated addresses left out of the snapshot")
+	flag.StringVar(&excludeFile, "exclude-file", "", "a file of addresses left out of the snapshot, one per line")
+}
+
+// Export the holders of a tick at a block height. The staked amount of a holder is counted
+// separately and removed from the frozen amount of the staking pool.
+func main() {
+	flag.Parse()
+	logger := log.With(log.NewStdLogger(os.Stderr),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+	)
+
+	log.SetLogger(logger)
+	helper := log.NewHelper(logger)
+
+	if tick == "" {
+		helper.Fatal("missing tick")
+	}
+
+	if format != "csv" && format != "json" {
+		helper.Fatalf("unknown format: %s", format)
+	}
+
+	minAmount, err := decimal.NewFromString(minTotal)
+	if err != nil {
+		helper.Fatalf("invalid min amount: %s", minTotal)
+	}
+
+	excluded, err := loadExclusions(exclude, excludeFile)
+	if err != nil {
+		panic(err)
+	}
+
+	app, cleanup, err := wireSnapshotApp(flagconf, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	// the holders at a height are read from the ledger, it must be seeded first.
+	pending, err := app.Migrator.Pending(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	if len(pending) > 0 {
+		helper.Fatalf("pending migrations %v, run cmd/migrate first", pending)
+	}
+
+	snapshot, err := app.Service.TakeSnapshot(context.Background(), service.SnapshotOptions{
+		Tick:        tick,
+		BlockNumber: height,
+		MinTotal:    minAmount,
+		Exclude:     excluded,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			panic(err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if format == "json" {
+		err = writeJSON(w, snapshot)
+	} else {
+		err = writeCSV(w, snapshot)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	helper.Infof("snapshot done. tick: %s, height: %d, holders: %d", snapshot.Tick, snapshot.BlockNumber, len(snapshot.Holders))
+}
+
+func loadExclusions(list, path string) (map[string]struct{}, error) {
+	var addresses = strings.Split(list, ",")
+
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); !strings.HasPrefix(line, "#") {
+				addresses = append(addresses, line)
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	var excluded = make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		if address = strings.ToLower(strings.TrimSpace(address)); address != "" {
+			excluded[address] = struct{}{}
+		}
+	}
+
+	return excluded, nil
+}
+
+func writeCSV(w io.Writer, snapshot *service.Snapshot) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"address", "available", "freeze", "staked", "total"}); err != nil {
+		return err
+	}
+
+	for _, holder := range snapshot.Holders {
+		err := cw.Write([]string{
+			holder.Address,
+			holder.Available.String(),
+			holder.Freeze.String(),
+			holder.Staked.String(),
+			holder.Total().String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeJSON(w io.Writer, snapshot *service.Snapshot) error {
+	type holder struct {
+		*service.Holder
+		Total decimal.Decimal `json:"total"`
+	}
+
+	var holders = make([]holder, 0, len(snapshot.Holders))
+	for _, h := range snapshot.Holders {
+		holders = append(holders, holder{Holder: h, Total: h.Total()})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Tick        string   `json:"tick"`
+		BlockNumber uint64   `json:"block_number"`
+		Holders     []holder `json:"holders"`
+	}{snapshot.Tick, snapshot.BlockNumber, holders})
+}
@@ -0,0 +1,58 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol/parser"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/service"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Injectors from wire.go:
+
+// wireSnapshotApp init snapshot service.
+func wireSnapshotApp(string2 string, logger log.Logger) (*snapshotApp, func(), error) {
+	config, cleanup, err := conf.NewConfigFromPath(string2, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup2, err := repository.NewDB(config, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	migrator := repository.NewMigrator(db, logger)
+	parserParser := parser.NewParser()
+	blockRepository := mysqlimpl.NewBlockRepo(db, parserParser)
+	bigCache, cleanup3, err := repository.NewCache()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	balanceRepository := repository.NewBalanceRepository(db, bigCache)
+	stakingRepository, err := repository.NewStakingRepository(db)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	snapshotService := service.NewSnapshotService(blockRepository, balanceRepository, stakingRepository)
+	mainSnapshotApp := &snapshotApp{
+		Migrator: migrator,
+		Service:  snapshotService,
+	}
+	return mainSnapshotApp, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
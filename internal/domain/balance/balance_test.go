@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -37,3 +38,18 @@ func (s *TestBalanceSuite) TestBalanceKey() {
 		spew.Dump(key, fmt.Sprintf("%p", key))
 	}
 }
+
+func (s *TestBalanceSuite) TestNewChange() {
+	before := &Balance{Address: "0x11", Tick: "ethi", Available: decimal.NewFromInt(10), Freeze: decimal.Zero, MintedAmount: decimal.NewFromInt(10)}
+	after := &Balance{Address: "0x11", Tick: "ethi", Available: decimal.NewFromInt(7), Freeze: decimal.NewFromInt(2), MintedAmount: decimal.NewFromInt(10), LastUpdatedBlock: 5}
+
+	change := NewChange(before, after)
+	s.Equal(uint64(5), change.BlockNumber)
+	s.Equal("-3", change.AvailableDelta.String())
+	s.Equal("2", change.FreezeDelta.String())
+	s.Equal("0", change.MintedDelta.String())
+	s.Equal("9", change.Balance().Total().String())
+
+	change = NewChange(nil, after)
+	s.Equal("7", change.AvailableDelta.String())
+}
@@ -0,0 +1,66 @@
+package balance
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrHistoryUnavailable is returned for a block before the balance history was recorded.
+var ErrHistoryUnavailable = errors.New("balance history is unavailable at the block")
+
+// Change is the state of a balance at the end of a block that changed it, with the amounts changed by the block.
+type Change struct {
+	ID             int64
+	Address        string
+	Tick           string
+	BlockNumber    uint64
+	Available      decimal.Decimal
+	Freeze         decimal.Decimal
+	MintedAmount   decimal.Decimal
+	AvailableDelta decimal.Decimal
+	FreezeDelta    decimal.Decimal
+	MintedDelta    decimal.Decimal
+}
+
+// NewChange returns the change from before to after, before is nil for a new balance.
+func NewChange(before, after *Balance) *Change {
+	change := &Change{
+		Address:        after.Address,
+		Tick:           after.Tick,
+		BlockNumber:    after.LastUpdatedBlock,
+		Available:      after.Available,
+		Freeze:         after.Freeze,
+		MintedAmount:   after.MintedAmount,
+		AvailableDelta: after.Available,
+		FreezeDelta:    after.Freeze,
+		MintedDelta:    after.MintedAmount,
+	}
+
+	if before != nil {
+		change.AvailableDelta = after.Available.Sub(before.Available)
+		change.FreezeDelta = after.Freeze.Sub(before.Freeze)
+		change.MintedDelta = after.MintedAmount.Sub(before.MintedAmount)
+	}
+
+	return change
+}
+
+// Balance returns the balance at the end of the block.
+func (c *Change) Balance() *Balance {
+	return &Balance{
+		Address:          c.Address,
+		Tick:             c.Tick,
+		Available:        c.Available,
+		Freeze:           c.Freeze,
+		MintedAmount:     c.MintedAmount,
+		LastUpdatedBlock: c.BlockNumber,
+	}
+}
+
+// ChangeQueryOptions pages the balance changes of an address, Tick filters the changes when set.
+type ChangeQueryOptions struct {
+	Tick   string
+	Cursor string
+	Size   int
+}
@@ -4,7 +4,34 @@ import (
 	"context"
 )
 
+// QueryOptions pages a balance query. Cursor is the next cursor of the previous page, empty for the first page.
+type QueryOptions struct {
+	Cursor       string
+	Size         int
+	OrderByTotal bool
+}
+
 type BalanceRepository interface {
 	Save(ctx context.Context, entities ...*Balance) error
 	Load(ctx context.Context, key BalanceKey) (*Balance, error)
+	// LoadMany returns the existing balances by key.
+	LoadMany(ctx context.Context, keys []BalanceKey) (map[BalanceKey]*Balance, error)
+	Rollback(ctx context.Context, blockNumber uint64) error
+
+	// QueryBalancesByAddress returns a page of balances of the address, ordered by tick or by total desc,
+	// with the cursor of the next page, empty on the last page.
+	QueryBalancesByAddress(ctx context.Context, address string, opts QueryOptions) ([]*Balance, string, error)
+	// QueryHoldersByTick returns a page of non-empty balances of the tick ordered by total desc,
+	// with the cursor of the next page, empty on the last page.
+	QueryHoldersByTick(ctx context.Context, tick string, opts QueryOptions) ([]*Balance, string, error)
+
+	// LoadAt returns the balance at the end of block blockNumber, nil if none. it returns ErrHistoryUnavailable
+	// when the block is before the history of the balance was recorded.
+	LoadAt(ctx context.Context, key BalanceKey, blockNumber uint64) (*Balance, error)
+	// QueryHoldersAt returns the non-empty balances of the tick at the end of block blockNumber, it returns
+	// ErrHistoryUnavailable when the block is before the history of any of them was recorded.
+	QueryHoldersAt(ctx context.Context, tick string, blockNumber uint64) ([]*Balance, error)
+	// QueryChanges returns a page of the balance changes of the address, latest first,
+	// with the cursor of the next page, empty on the last page.
+	QueryChanges(ctx context.Context, address string, opts ChangeQueryOptions) ([]*Change, string, error)
 }
@@ -23,6 +23,25 @@ func (b *BlockHeader) String() string {
 	}
 }
 
+type BlockTag string
+
+const (
+	BlockTagLatest    BlockTag = "latest"
+	BlockTagSafe      BlockTag = "safe"
+	BlockTagFinalized BlockTag = "finalized"
+)
+
+func ParseBlockTag(tag string) (BlockTag, error) {
+	switch BlockTag(tag) {
+	case "", BlockTagLatest:
+		return BlockTagLatest, nil
+	case BlockTagSafe, BlockTagFinalized:
+		return BlockTag(tag), nil
+	default:
+		return "", fmt.Errorf("invalid block tag: %s", tag)
+	}
+}
+
 type BlockHandleStatus struct {
 	LatestBlock      *BlockHeader
 	LastIndexedBlock *BlockHeader
@@ -3,9 +3,13 @@ package domain
 import (
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
+	mapset "github.com/deckarep/golang-set/v2"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/shopspring/decimal"
 )
@@ -267,15 +271,63 @@ func NewEventFromData(kind uint8, data []byte) Event {
 	}
 }
 
+// EventCursor is a position in the event log, ordered by block number then event id.
+// event ids are auto incremented in block order, so the cursor of the events delivered only increases.
+type EventCursor struct {
+	BlockNumber uint64
+	EventID     int64
+}
+
+// BlockCursor returns the position after all events of blockNumber.
+func BlockCursor(blockNumber uint64) EventCursor {
+	return EventCursor{BlockNumber: blockNumber, EventID: math.MaxInt64}
+}
+
+func ParseEventCursor(cursor string) (EventCursor, error) {
+	values, err := utils.DecodeCursor(cursor, 2)
+	if err != nil {
+		return EventCursor{}, err
+	}
+
+	blockNumber, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil {
+		return EventCursor{}, utils.ErrInvalidCursor
+	}
+
+	id, err := strconv.ParseInt(values[1], 10, 64)
+	if err != nil {
+		return EventCursor{}, utils.ErrInvalidCursor
+	}
+
+	return EventCursor{BlockNumber: blockNumber, EventID: id}, nil
+}
+
+func (c EventCursor) String() string {
+	return utils.EncodeCursor(strconv.FormatUint(c.BlockNumber, 10), strconv.FormatInt(c.EventID, 10))
+}
+
+func (c EventCursor) Less(other EventCursor) bool {
+	return c.BlockNumber < other.BlockNumber || (c.BlockNumber == other.BlockNumber && c.EventID < other.EventID)
+}
+
 type EventsByBlock struct {
 	BlockNumber uint64
 	Events      []Event
+	// LastEventID is the id of the last event, or of the last event at or below BlockNumber on rollback.
+	LastEventID int64
+
+	// Rollback marks a chain reorganization, events above BlockNumber have been revoked.
+	Rollback bool
 }
 
 func (e *EventsByBlock) CurrentBlock() uint64 {
 	return e.BlockNumber
 }
 
+func (e *EventsByBlock) Cursor() EventCursor {
+	return EventCursor{BlockNumber: e.BlockNumber, EventID: e.LastEventID}
+}
+
 func (e *EventsByBlock) PreviousBlock() uint64 {
 	if len(e.Events) == 0 {
 		return 0
@@ -283,3 +335,92 @@ func (e *EventsByBlock) PreviousBlock() uint64 {
 
 	return e.Events[0].GetPreviousBlock()
 }
+
+// EventIndex returns the tick and the ierc from / to addresses an event is indexed by.
+func EventIndex(e Event) (tick, from, to string) {
+	switch ee := e.(type) {
+	case *IERC20TickCreatedEvent:
+		return ee.Data.Tick, ee.From, ee.To
+	case *IERC20MintedEvent:
+		return ee.Data.Tick, ee.Data.From, ee.Data.To
+	case *IERCPoWTickCreatedEvent:
+		return ee.Data.Tick, ee.From, ee.To
+	case *IERCPoWMintedEvent:
+		return ee.Data.Tick, ee.Data.From, ee.Data.To
+	case *IERC20TransferredEvent:
+		return ee.Data.Tick, ee.Data.From, ee.Data.To
+	case *StakingPoolUpdatedEvent:
+		return "", ee.From, ee.To
+	default:
+		return "", "", ""
+	}
+}
+
+// EventFilter selects the events of a subscription, empty fields match all.
+type EventFilter struct {
+	Ticks         mapset.Set[string]
+	Addresses     mapset.Set[string]
+	Operates      mapset.Set[protocol.Operate]
+	ExcludeFailed bool
+}
+
+func NewEventFilter(ticks []string, addresses []string, operates []protocol.Operate, excludeFailed bool) *EventFilter {
+	return &EventFilter{
+		Ticks:         mapset.NewThreadUnsafeSet(ticks...),
+		Addresses:     mapset.NewThreadUnsafeSet(addresses...),
+		Operates:      mapset.NewThreadUnsafeSet(operates...),
+		ExcludeFailed: excludeFailed,
+	}
+}
+
+// IsEmpty reports whether the filter matches all events. a nil filter is empty.
+func (f *EventFilter) IsEmpty() bool {
+	return f == nil ||
+		(f.Ticks.Cardinality() == 0 && f.Addresses.Cardinality() == 0 && f.Operates.Cardinality() == 0 && !f.ExcludeFailed)
+}
+
+func (f *EventFilter) Match(e Event) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	if f.ExcludeFailed && e.GetErrCode() != 0 {
+		return false
+	}
+
+	if f.Operates.Cardinality() > 0 && !f.Operates.Contains(e.GetOperate()) {
+		return false
+	}
+
+	tick, from, to := EventIndex(e)
+	if f.Ticks.Cardinality() > 0 && !f.Ticks.Contains(tick) {
+		return false
+	}
+
+	if f.Addresses.Cardinality() > 0 && !f.Addresses.Contains(from) && !f.Addresses.Contains(to) {
+		return false
+	}
+
+	return true
+}
+
+// Apply returns the block with the matched events only. rollback markers are always kept,
+// nil is returned when no event of the block matches.
+func (f *EventFilter) Apply(block *EventsByBlock) *EventsByBlock {
+	if f.IsEmpty() || block.Rollback {
+		return block
+	}
+
+	var events []Event
+	for _, e := range block.Events {
+		if f.Match(e) {
+			events = append(events, e)
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return &EventsByBlock{BlockNumber: block.BlockNumber, Events: events, LastEventID: block.LastEventID}
+}
@@ -0,0 +1,143 @@
+package market
+
+import (
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
+)
+
+type ListingStatus uint8
+
+const (
+	ListingListed ListingStatus = iota + 1
+	ListingSold
+	ListingCancelled
+)
+
+func (s ListingStatus) String() string {
+	switch s {
+	case ListingListed:
+		return "listed"
+	case ListingSold:
+		return "sold"
+	case ListingCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// Listing is a freeze_sell order, identified by the tx hash and position of the event listing it.
+// a signature is listed again after the listing is cancelled, so it may have several listings.
+type Listing struct {
+	ID          int64
+	TxHash      string
+	Position    int
+	Sign        string
+	Protocol    protocol.Protocol
+	Tick        string
+	Seller      string
+	Platform    string
+	Amount      decimal.Decimal
+	Value       decimal.Decimal
+	Price       decimal.Decimal
+	Status      ListingStatus
+	ListedBlock uint64
+
+	// set when the listing is sold or cancelled
+	Buyer        string
+	ClosedTxHash string
+	ClosedBlock  uint64
+}
+
+func (l *Listing) close(c *ListingClose) {
+	l.Status = c.Status
+	l.Buyer = c.Buyer
+	l.ClosedTxHash = c.TxHash
+	l.ClosedBlock = c.BlockNumber
+}
+
+// ListingClose closes the open listing of a signature, by a proxy_transfer or an unfreeze_sell.
+type ListingClose struct {
+	Sign        string
+	Status      ListingStatus
+	Buyer       string
+	TxHash      string
+	BlockNumber uint64
+}
+
+// ListingUpdates is the change of the listings made by a sequence of events. the closes are
+// applied to the listings saved before, then the listings are created.
+type ListingUpdates struct {
+	Listings []*Listing
+	Closes   []*ListingClose
+}
+
+func (u *ListingUpdates) IsEmpty() bool {
+	return len(u.Listings) == 0 && len(u.Closes) == 0
+}
+
+// NewListingUpdates folds the succeeded trade events, in order. a listing closed by the events is
+// created closed.
+func NewListingUpdates(events []domain.Event) *ListingUpdates {
+	var (
+		updates = &ListingUpdates{}
+		open    = make(map[string]*Listing)
+	)
+
+	for _, event := range events {
+		ee, ok := event.(*domain.IERC20TransferredEvent)
+		if !ok || ee.ErrCode != 0 || ee.Data == nil || ee.Data.Sign == "" {
+			continue
+		}
+
+		var c = &ListingClose{Sign: ee.Data.Sign, TxHash: ee.TxHash, BlockNumber: ee.BlockNumber}
+		switch ee.Data.Operate {
+		case protocol.OpFreezeSell:
+			listing := &Listing{
+				TxHash:      ee.TxHash,
+				Position:    ee.PositionInIERCTxs,
+				Sign:        ee.Data.Sign,
+				Protocol:    ee.Data.Protocol,
+				Tick:        ee.Data.Tick,
+				Seller:      ee.Data.From,
+				Platform:    ee.To,
+				Amount:      ee.Data.Amount,
+				Value:       ee.Data.EthValue,
+				Price:       unitPrice(ee.Data.EthValue, ee.Data.Amount),
+				Status:      ListingListed,
+				ListedBlock: ee.BlockNumber,
+			}
+			open[listing.Sign] = listing
+			updates.Listings = append(updates.Listings, listing)
+			continue
+
+		case protocol.OpProxyTransfer:
+			c.Status, c.Buyer = ListingSold, ee.Data.To
+
+		case protocol.OpUnfreezeSell:
+			c.Status = ListingCancelled
+
+		default:
+			continue
+		}
+
+		if listing, existed := open[c.Sign]; existed {
+			listing.close(c)
+			delete(open, c.Sign)
+			continue
+		}
+
+		updates.Closes = append(updates.Closes, c)
+	}
+
+	return updates
+}
+
+func unitPrice(value, amount decimal.Decimal) decimal.Decimal {
+	if amount.Sign() <= 0 {
+		return decimal.Zero
+	}
+
+	return value.DivRound(amount, 18)
+}
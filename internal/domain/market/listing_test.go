@@ -0,0 +1,53 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTradeEvent(operate protocol.Operate, txHash, sign, from, to string) *domain.IERC20TransferredEvent {
+	return &domain.IERC20TransferredEvent{
+		BlockNumber: 10,
+		TxHash:      txHash,
+		To:          protocol.PlatformAddress,
+		Data: &domain.IERC20Transferred{
+			Operate:  operate,
+			Tick:     "ethi",
+			From:     from,
+			To:       to,
+			Amount:   decimal.NewFromInt(1000),
+			EthValue: decimal.RequireFromString("0.5"),
+			Sign:     sign,
+		},
+	}
+}
+
+func TestNewListingUpdates(t *testing.T) {
+	failed := newTradeEvent(protocol.OpFreezeSell, "0x04", "s3", "0xseller", "0xseller")
+	failed.ErrCode = int32(protocol.InsufficientAvailableFunds)
+
+	updates := NewListingUpdates([]domain.Event{
+		// s1 was listed by an earlier block.
+		newTradeEvent(protocol.OpProxyTransfer, "0x01", "s1", "0xseller", "0xbuyer"),
+		newTradeEvent(protocol.OpFreezeSell, "0x02", "s2", "0xseller", "0xseller"),
+		newTradeEvent(protocol.OpUnfreezeSell, "0x03", "s2", "", ""),
+		newTradeEvent(protocol.OpFreezeSell, "0x03", "s2", "0xseller", "0xseller"),
+		failed,
+	})
+
+	assert.Len(t, updates.Closes, 1)
+	assert.Equal(t, ListingClose{Sign: "s1", Status: ListingSold, Buyer: "0xbuyer", TxHash: "0x01", BlockNumber: 10}, *updates.Closes[0])
+
+	// the signature cancelled in the block is listed again.
+	assert.Len(t, updates.Listings, 2)
+	assert.Equal(t, ListingCancelled, updates.Listings[0].Status)
+	assert.Equal(t, "0x03", updates.Listings[0].ClosedTxHash)
+	assert.Equal(t, ListingListed, updates.Listings[1].Status)
+	assert.Equal(t, "0xseller", updates.Listings[1].Seller)
+	assert.Equal(t, protocol.PlatformAddress, updates.Listings[1].Platform)
+	assert.Equal(t, "0.0005", updates.Listings[1].Price.String())
+}
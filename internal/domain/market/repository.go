@@ -0,0 +1,52 @@
+package market
+
+import (
+	"context"
+)
+
+// ListingQueryOptions filters and pages a listing query. Status 0 matches all statuses,
+// listings are sorted by listing order, or by price ascending when OrderByPrice is set.
+type ListingQueryOptions struct {
+	Tick         string
+	Seller       string
+	Status       ListingStatus
+	OrderByPrice bool
+	Cursor       string
+	Size         int
+}
+
+type ListingRepository interface {
+	Save(ctx context.Context, updates *ListingUpdates) error
+	Rollback(ctx context.Context, blockNumber uint64) error
+
+	// QueryListing returns the latest listing of the signature, nil if none.
+	QueryListing(ctx context.Context, sign string) (*Listing, error)
+	// QueryListings returns a page of listings with the cursor of the next page, empty on the last page.
+	QueryListings(ctx context.Context, opts ListingQueryOptions) ([]*Listing, string, error)
+}
+
+type StatsPeriod uint8
+
+const (
+	StatsPeriodBlock StatsPeriod = iota + 1
+	StatsPeriodDay
+)
+
+// StatsQueryOptions pages the statistics of a tick of a period, latest first.
+type StatsQueryOptions struct {
+	Tick   string
+	Period StatsPeriod
+	Cursor string
+	Size   int
+}
+
+type StatsRepository interface {
+	// Save accumulates the statistics of a block on the latest ones of the ticks, and rolls them up into their days.
+	Save(ctx context.Context, stats ...*TickStats) error
+	Rollback(ctx context.Context, blockNumber uint64) error
+
+	// QueryLatest returns the statistics of the tick at its latest block, nil if none.
+	QueryLatest(ctx context.Context, tick string) (*TickStats, error)
+	// QueryStats returns a page of statistics with the cursor of the next page, empty on the last page.
+	QueryStats(ctx context.Context, opts StatsQueryOptions) ([]*TickStats, string, error)
+}
@@ -0,0 +1,202 @@
+package market
+
+import (
+	"sort"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
+)
+
+// TickStats is the statistics of a tick over a period, a block or a UTC day. the activity is
+// of the period, the holders, totals and last price are at the end of the period.
+type TickStats struct {
+	Tick string
+	// the last block of the period
+	BlockNumber uint64
+	Day         time.Time
+
+	HolderDelta int64
+	Transfers   int64
+	Mints       int64
+	Minted      decimal.Decimal
+	Trades      int64
+	Volume      decimal.Decimal
+
+	Holders        int64
+	TotalTransfers int64
+	TotalMints     int64
+	TotalMinted    decimal.Decimal
+	TotalTrades    int64
+	TotalVolume    decimal.Decimal
+	LastPrice      decimal.Decimal
+}
+
+func newTickStats(tick string, blockNumber uint64, at time.Time) *TickStats {
+	return &TickStats{
+		Tick:        tick,
+		BlockNumber: blockNumber,
+		Day:         DayOf(at),
+		Minted:      decimal.Zero,
+		Volume:      decimal.Zero,
+		TotalMinted: decimal.Zero,
+		TotalVolume: decimal.Zero,
+		LastPrice:   decimal.Zero,
+	}
+}
+
+// DayOf returns the UTC day of t.
+func DayOf(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// Accumulate sets the values at the end of the block from the statistics of the previous block of the tick,
+// nil for the first block.
+func (s *TickStats) Accumulate(prev *TickStats) {
+	s.Holders = s.HolderDelta
+	s.TotalTransfers = s.Transfers
+	s.TotalMints = s.Mints
+	s.TotalMinted = s.Minted
+	s.TotalTrades = s.Trades
+	s.TotalVolume = s.Volume
+	if prev == nil {
+		return
+	}
+
+	s.Holders += prev.Holders
+	s.TotalTransfers += prev.TotalTransfers
+	s.TotalMints += prev.TotalMints
+	s.TotalMinted = s.TotalMinted.Add(prev.TotalMinted)
+	s.TotalTrades += prev.TotalTrades
+	s.TotalVolume = s.TotalVolume.Add(prev.TotalVolume)
+	if s.Trades == 0 {
+		s.LastPrice = prev.LastPrice
+	}
+}
+
+// Roll adds the statistics of a later block of the same day.
+func (s *TickStats) Roll(block *TickStats) {
+	s.BlockNumber = block.BlockNumber
+
+	s.HolderDelta += block.HolderDelta
+	s.Transfers += block.Transfers
+	s.Mints += block.Mints
+	s.Minted = s.Minted.Add(block.Minted)
+	s.Trades += block.Trades
+	s.Volume = s.Volume.Add(block.Volume)
+
+	s.Holders = block.Holders
+	s.TotalTransfers = block.TotalTransfers
+	s.TotalMints = block.TotalMints
+	s.TotalMinted = block.TotalMinted
+	s.TotalTrades = block.TotalTrades
+	s.TotalVolume = block.TotalVolume
+	s.LastPrice = block.LastPrice
+}
+
+// NewDayStats returns the statistics of the day of the block, starting with the block.
+func NewDayStats(block *TickStats) *TickStats {
+	day := newTickStats(block.Tick, block.BlockNumber, block.Day)
+	day.Roll(block)
+	return day
+}
+
+// HoldingKeys returns the balances holding any amount, taken before the block is handled
+// to count the holders gained and lost by the block.
+func HoldingKeys(balances map[balance.BalanceKey]*balance.Balance) map[balance.BalanceKey]struct{} {
+	var keys = make(map[balance.BalanceKey]struct{})
+	for key, entity := range balances {
+		if entity.Total().Sign() > 0 {
+			keys[key] = struct{}{}
+		}
+	}
+
+	return keys
+}
+
+// NewBlockStats returns the activity of the ticks changed by the block, sorted by tick. holding is
+// the HoldingKeys before the block and balances the balances after it.
+func NewBlockStats(
+	blockNumber uint64,
+	events []domain.Event,
+	holding map[balance.BalanceKey]struct{},
+	balances map[balance.BalanceKey]*balance.Balance,
+) []*TickStats {
+	var stats = make(map[string]*TickStats)
+	getOrCreate := func(tick string, at time.Time) *TickStats {
+		s, existed := stats[tick]
+		if !existed {
+			s = newTickStats(tick, blockNumber, at)
+			stats[tick] = s
+		}
+		return s
+	}
+
+	var blockAt time.Time
+	for _, event := range events {
+		if blockAt.IsZero() {
+			blockAt = event.GetEventAt()
+		}
+
+		if event.GetErrCode() != 0 {
+			continue
+		}
+
+		switch ee := event.(type) {
+		case *domain.IERC20MintedEvent:
+			s := getOrCreate(ee.Data.Tick, ee.EventAt)
+			s.Mints++
+			s.Minted = s.Minted.Add(ee.Data.MintedAmount)
+
+		case *domain.IERCPoWMintedEvent:
+			s := getOrCreate(ee.Data.Tick, ee.EventAt)
+			if ee.Data.Operate == protocol.OpMint {
+				s.Mints++
+				s.Minted = s.Minted.Add(ee.Data.PoWMintedAmount).Add(ee.Data.PoSMintedAmount)
+			}
+
+		case *domain.IERC20TransferredEvent:
+			s := getOrCreate(ee.Data.Tick, ee.EventAt)
+			switch ee.Data.Operate {
+			case protocol.OpTransfer:
+				s.Transfers++
+
+			case protocol.OpProxyTransfer:
+				s.Transfers++
+				s.Trades++
+				s.Volume = s.Volume.Add(ee.Data.EthValue)
+				s.LastPrice = unitPrice(ee.Data.EthValue, ee.Data.Amount)
+			}
+		}
+	}
+
+	for key, entity := range balances {
+		if entity.LastUpdatedBlock < blockNumber {
+			continue
+		}
+
+		_, held := holding[key]
+		holds := entity.Total().Sign() > 0
+		if held == holds {
+			continue
+		}
+
+		// staking changes balances without an event of the tick.
+		s := getOrCreate(entity.Tick, blockAt)
+		if holds {
+			s.HolderDelta++
+		} else {
+			s.HolderDelta--
+		}
+	}
+
+	var result = make([]*TickStats, 0, len(stats))
+	for _, s := range stats {
+		result = append(result, s)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Tick < result[j].Tick })
+	return result
+}
@@ -0,0 +1,80 @@
+package market
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBlockStats(t *testing.T) {
+	at := time.Date(2024, 3, 1, 23, 59, 0, 0, time.UTC)
+	newBalance := func(address string, available int64, block uint64) *balance.Balance {
+		return &balance.Balance{Address: address, Tick: "ethi", Available: decimal.NewFromInt(available), LastUpdatedBlock: block}
+	}
+
+	// 0x01 sells all to 0x02, 0x03 mints, 0x04 is unchanged.
+	before := map[balance.BalanceKey]*balance.Balance{
+		balance.NewBalanceKey("0x01", "ethi"): newBalance("0x01", 1000, 9),
+		balance.NewBalanceKey("0x04", "ethi"): newBalance("0x04", 10, 9),
+	}
+	holding := HoldingKeys(before)
+
+	after := map[balance.BalanceKey]*balance.Balance{
+		balance.NewBalanceKey("0x01", "ethi"): newBalance("0x01", 0, 10),
+		balance.NewBalanceKey("0x02", "ethi"): newBalance("0x02", 1000, 10),
+		balance.NewBalanceKey("0x03", "ethi"): newBalance("0x03", 100, 10),
+		balance.NewBalanceKey("0x04", "ethi"): newBalance("0x04", 10, 9),
+	}
+
+	trade := newTradeEvent(protocol.OpProxyTransfer, "0x01", "s1", "0x01", "0x02")
+	trade.EventAt = at
+	failed := &domain.IERC20MintedEvent{
+		BlockNumber: 10,
+		ErrCode:     int32(protocol.MintErrTickMinted),
+		Data:        &domain.IERC20Minted{Operate: protocol.OpMint, Tick: "ethi", MintedAmount: decimal.NewFromInt(100)},
+		EventAt:     at,
+	}
+	mint := &domain.IERC20MintedEvent{
+		BlockNumber: 10,
+		Data:        &domain.IERC20Minted{Operate: protocol.OpMint, Tick: "ethi", MintedAmount: decimal.NewFromInt(100)},
+		EventAt:     at,
+	}
+
+	stats := NewBlockStats(10, []domain.Event{trade, failed, mint}, holding, after)
+	assert.Len(t, stats, 1)
+
+	s := stats[0]
+	assert.Equal(t, "2024-03-01", s.Day.Format(time.DateOnly))
+	assert.Equal(t, int64(1), s.HolderDelta)
+	assert.Equal(t, int64(1), s.Transfers)
+	assert.Equal(t, int64(1), s.Mints)
+	assert.Equal(t, "100", s.Minted.String())
+	assert.Equal(t, int64(1), s.Trades)
+	assert.Equal(t, "0.5", s.Volume.String())
+	assert.Equal(t, "0.0005", s.LastPrice.String())
+
+	prev := &TickStats{Tick: "ethi", BlockNumber: 9, Holders: 2, TotalTrades: 3, TotalVolume: decimal.NewFromInt(2), LastPrice: decimal.NewFromInt(1)}
+	s.Accumulate(prev)
+	assert.Equal(t, int64(3), s.Holders)
+	assert.Equal(t, int64(4), s.TotalTrades)
+	assert.Equal(t, "2.5", s.TotalVolume.String())
+	assert.Equal(t, "0.0005", s.LastPrice.String())
+
+	// a later block of the day without trades keeps the last price.
+	next := NewBlockStats(11, []domain.Event{mint}, nil, nil)[0]
+	next.Accumulate(s)
+	assert.Equal(t, "0.0005", next.LastPrice.String())
+
+	day := NewDayStats(s)
+	day.Roll(next)
+	assert.Equal(t, uint64(11), day.BlockNumber)
+	assert.Equal(t, int64(2), day.Mints)
+	assert.Equal(t, int64(1), day.Trades)
+	assert.Equal(t, int64(3), day.Holders)
+	assert.Equal(t, "200", day.Minted.String())
+}
@@ -0,0 +1,91 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Message is a record of the outbox. the ids increase in commit order, the blocks being committed one at a time.
+type Message struct {
+	ID          int64
+	Key         string // tick
+	BlockNumber uint64
+	Payload     []byte
+	CreatedAt   time.Time
+}
+
+// Payload is the content of a message: the events of a tick in a block, or the revocation of
+// the events of a tick above the block on rollback.
+type Payload struct {
+	BlockNumber uint64         `json:"block_number"`
+	Tick        string         `json:"tick"`
+	Rollback    bool           `json:"rollback,omitempty"`
+	Events      []PayloadEvent `json:"events,omitempty"`
+}
+
+// PayloadEvent is decoded by domain.NewEventFromData(kind, event).
+type PayloadEvent struct {
+	Kind  domain.EventKind    `json:"kind"`
+	Event jsoniter.RawMessage `json:"event"`
+}
+
+// NewMessages splits the events of a block by tick, in the order of the events.
+func NewMessages(block *domain.EventsByBlock) ([]*Message, error) {
+	var (
+		payloads = make(map[string]*Payload)
+		ticks    []string
+	)
+
+	for _, event := range block.Events {
+		tick, _, _ := domain.EventIndex(event)
+
+		data, err := jsoniter.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+
+		payload, existed := payloads[tick]
+		if !existed {
+			payload = &Payload{BlockNumber: block.BlockNumber, Tick: tick}
+			payloads[tick] = payload
+			ticks = append(ticks, tick)
+		}
+		payload.Events = append(payload.Events, PayloadEvent{Kind: event.GetEventKind(), Event: data})
+	}
+
+	var messages = make([]*Message, 0, len(ticks))
+	for _, tick := range ticks {
+		message, err := newMessage(payloads[tick])
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// NewRollbackMessages returns the rollback messages of the ticks having events above blockNumber.
+func NewRollbackMessages(blockNumber uint64, ticks []string) ([]*Message, error) {
+	var messages = make([]*Message, 0, len(ticks))
+	for _, tick := range ticks {
+		message, err := newMessage(&Payload{BlockNumber: blockNumber, Tick: tick, Rollback: true})
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func newMessage(payload *Payload) (*Message, error) {
+	data, err := jsoniter.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{Key: payload.Tick, BlockNumber: payload.BlockNumber, Payload: data}, nil
+}
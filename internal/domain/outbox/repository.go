@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+)
+
+type Repository interface {
+	// Save writes the messages of a block in the transaction of ctx.
+	Save(ctx context.Context, block *domain.EventsByBlock) error
+	// Rollback writes the rollback messages in the transaction of ctx, before the events above blockNumber are deleted.
+	Rollback(ctx context.Context, blockNumber uint64) error
+
+	// GetOffset returns the id of the last message relayed to the broker, 0 if none.
+	GetOffset(ctx context.Context) (int64, error)
+	// Load returns the messages after the offset, ordered by id.
+	Load(ctx context.Context, offset int64, limit int) ([]*Message, error)
+	Commit(ctx context.Context, offset int64) error
+	// Purge deletes the messages up to the offset created before.
+	Purge(ctx context.Context, offset int64, before time.Time) error
+}
+
+// Broker ships the messages to a message broker.
+type Broker interface {
+	// Publish stores the messages in order and returns once all of them are acknowledged.
+	// it is idempotent: the messages already stored are skipped, so that a batch can be published
+	// again when the offset was not committed.
+	Publish(ctx context.Context, messages []*Message) error
+	Close() error
+}
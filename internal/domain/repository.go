@@ -2,14 +2,24 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
 	"github.com/google/uuid"
 )
 
 type BlockFetcher interface {
 	GetBlockNumber(ctx context.Context) (uint64, error)
 	GetBlockHeaderByNumber(ctx context.Context, blockNumber uint64) (*BlockHeader, error)
+	GetBlockHeaderByTag(ctx context.Context, tag BlockTag) (*BlockHeader, error)
 	GetBlockByNumber(ctx context.Context, targetBlock uint64) (*Block, error)
+	// GetBlocksByRange returns blocks [startAt, startAt+size) ordered by number.
+	GetBlocksByRange(ctx context.Context, startAt uint64, size uint64) ([]*Block, error)
+	// RangeSize returns the number of blocks of a sync round, sized to the current throughput of the fetcher.
+	RangeSize() uint64
+	// SubscribeNewHead notifies new chain heads until ctx is done. the channel is nil when subscription is not available.
+	SubscribeNewHead(ctx context.Context) (<-chan *BlockHeader, error)
 }
 
 type BlockRepository interface {
@@ -20,11 +30,17 @@ type BlockRepository interface {
 
 	QueryLastProcessedBlock(ctx context.Context, blockNumber uint64) (*BlockHeader, error)
 	QueryTransactionByHash(ctx context.Context, hash string) (*Transaction, error)
+	QueryBlockHeaderByNumber(ctx context.Context, blockNumber uint64) (*BlockHeader, error)
 
 	BulkSaveBlock(ctx context.Context, blocks []*Block) error
 	Update(ctx context.Context, block *Block) error
+	Rollback(ctx context.Context, blockNumber uint64) error
+	Reset(ctx context.Context, blockNumber uint64) error
 }
 
+// ErrSlowSubscriber is sent to a subscriber that has not received data for too long.
+var ErrSlowSubscriber = errors.New("subscriber is too slow to keep up")
+
 type Stream[T any] struct {
 	id     string
 	dataCh chan *T
@@ -35,7 +51,7 @@ func NewEventStream[T any](size int) *Stream[T] {
 	return &Stream[T]{
 		id:     uuid.NewString(),
 		dataCh: make(chan *T, size),
-		errCh:  make(chan error),
+		errCh:  make(chan error, 1),
 	}
 }
 
@@ -71,15 +87,43 @@ func (s *Stream[T]) Close() {
 	close(s.errCh)
 }
 
+// EventQuery filters and pages events above StartBlock, zero values match all.
+// Cursor is the next cursor of the previous page, empty for the first page.
+type EventQuery struct {
+	StartBlock  uint64
+	Tick        string
+	Address     string
+	Operate     protocol.Operate
+	ErrCode     *int32
+	EventAtFrom time.Time
+	EventAtTo   time.Time
+	Cursor      string
+	Size        int
+}
+
 type EventRepository interface {
 	Save(ctx context.Context, event *EventsByBlock) error
 
 	GetBlockNumberByLastEvent(ctx context.Context) (uint64, error)
-	QueryEventBySignature(ctx context.Context, signs []string) (map[string]Event, error)
-	SubscribeEvent(ctx context.Context, startBlock uint64) (*Stream[EventsByBlock], error)
-	LoadEventsByBlocks(ctx context.Context, startBlock uint64, limit int) ([]*EventsByBlock, error)
+	// LoadMany returns the latest successful event of every signature.
+	LoadMany(ctx context.Context, signs []string) (map[string]Event, error)
+	// SubscribeEvent streams the events after the cursor matched by filter, nil filter matches all.
+	SubscribeEvent(ctx context.Context, after EventCursor, filter *EventFilter) (*Stream[EventsByBlock], error)
+	// LoadEventsByBlocks loads the events after the cursor grouped by block, the last block is always complete.
+	LoadEventsByBlocks(ctx context.Context, after EventCursor, limit int, filter *EventFilter) ([]*EventsByBlock, error)
 	QueryEventsByBlocks(ctx context.Context, startBlock uint64, blockNum int) ([]*EventsByBlock, error)
 	QueryEventsByHash(ctx context.Context, hash string) ([]Event, error)
+	// QueryEvents returns a page of events ordered by (block_number, id) and grouped by block,
+	// with the cursor of the next page, empty on the last page.
+	QueryEvents(ctx context.Context, query EventQuery) ([]*EventsByBlock, string, error)
+	Rollback(ctx context.Context, blockNumber uint64) error
+}
+
+type JournalRepository interface {
+	// GetFirstJournalBlock returns the first block whose state changes were journaled, 0 if none.
+	GetFirstJournalBlock(ctx context.Context) (uint64, error)
+	// PruneJournals deletes the journals of the blocks up to blockNumber, they can no longer be rolled back.
+	PruneJournals(ctx context.Context, blockNumber uint64) error
 }
 
 type TransactionRepository interface {
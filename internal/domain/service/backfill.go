@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	mapset "github.com/deckarep/golang-set/v2"
+	"golang.org/x/sync/errgroup"
+)
+
+// stateKeys is the set of state read or written by a block.
+type stateKeys struct {
+	ticks      mapset.Set[string]
+	balances   mapset.Set[balance.BalanceKey]
+	signatures mapset.Set[string]
+}
+
+func newStateKeys() *stateKeys {
+	return &stateKeys{
+		ticks:      mapset.NewSet[string](),
+		balances:   mapset.NewSet[balance.BalanceKey](),
+		signatures: mapset.NewSet[string](),
+	}
+}
+
+func (s *stateKeys) Merge(other *stateKeys) {
+	s.ticks = s.ticks.Union(other.ticks)
+	s.balances = s.balances.Union(other.balances)
+	s.signatures = s.signatures.Union(other.signatures)
+}
+
+func (s *stateKeys) Intersects(other *stateKeys) bool {
+	return intersects(s.ticks, other.ticks) ||
+		intersects(s.balances, other.balances) ||
+		intersects(s.signatures, other.signatures)
+}
+
+func intersects[T comparable](a, b mapset.Set[T]) bool {
+	if a.Cardinality() > b.Cardinality() {
+		a, b = b, a
+	}
+
+	found := false
+	a.Each(func(item T) bool {
+		found = b.Contains(item)
+		return found
+	})
+
+	return found
+}
+
+// writeKeys returns the state written by a handled block.
+func writeKeys(root *domain.AggregateRoot) *stateKeys {
+	var (
+		writes          = newStateKeys()
+		ticks, balances = changedEntities(root)
+	)
+
+	for _, entity := range ticks {
+		writes.ticks.Add(entity.GetName())
+	}
+
+	for _, entity := range balances {
+		writes.balances.Add(entity.Key())
+	}
+
+	for _, event := range root.Events {
+		if e, ok := event.(*domain.IERC20TransferredEvent); ok && e.Data.Sign != "" {
+			writes.signatures.Add(e.Data.Sign)
+		}
+	}
+
+	return writes
+}
+
+// HandleBlocks handles consecutive blocks. blocks are preprocessed in parallel and committed in order,
+// a block is preprocessed again when it read the state written by an earlier block of the batch.
+func (b *BlockService) HandleBlocks(ctx context.Context, blocks []*domain.Block) error {
+	if len(blocks) == 1 {
+		return b.HandleBlock(ctx, blocks[0])
+	}
+
+	var (
+		start      = time.Now()
+		aggregates = make([]*domain.AggregateRoot, len(blocks))
+		reads      = make([]*stateKeys, len(blocks))
+		eg, gCtx   = errgroup.WithContext(ctx)
+	)
+
+	for idx, block := range blocks {
+		idx, block := idx, block
+		eg.Go(func() error {
+			aggregate, keys, err := b.preprocessing(gCtx, block)
+			if err != nil {
+				return err
+			}
+
+			aggregates[idx], reads[idx] = aggregate, keys
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	var (
+		written = newStateKeys()
+		reruns  int
+	)
+
+	for idx, block := range blocks {
+		aggregate := aggregates[idx]
+
+		if reads[idx].Intersects(written) {
+			var err error
+			if aggregate, _, err = b.preprocessing(ctx, block); err != nil {
+				return err
+			}
+			reruns++
+		}
+
+		if err := b.commit(ctx, aggregate); err != nil {
+			return err
+		}
+
+		written.Merge(writeKeys(aggregate))
+	}
+
+	b.logger.Infof("handle blocks done. start_block: %d, end_block: %d, reruns: %d, duration: %v",
+		blocks[0].Number, blocks[len(blocks)-1].Number, reruns, time.Since(start))
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/market"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/tick"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateKeysIntersects(t *testing.T) {
+	root := domain.NewBlockAggregate(0, &domain.Block{Number: 10}, nil, 0)
+	root.TicksMap["ethi"] = &tick.IERC20Tick{Tick: "ethi", LastUpdatedAtBlock: 10}
+	root.TicksMap["other"] = &tick.IERC20Tick{Tick: "other", LastUpdatedAtBlock: 9}
+	root.BalancesMap[balance.NewBalanceKey("0x01", "ethi")] = &balance.Balance{Address: "0x01", Tick: "ethi", LastUpdatedBlock: 10}
+
+	writes := writeKeys(root)
+
+	reads := newStateKeys()
+	reads.ticks.Add("other")
+	reads.balances.Add(balance.NewBalanceKey("0x02", "ethi"))
+	assert.False(t, reads.Intersects(writes))
+
+	reads.balances.Add(balance.NewBalanceKey("0x01", "ethi"))
+	assert.True(t, reads.Intersects(writes))
+
+	merged := newStateKeys()
+	merged.Merge(writes)
+	assert.True(t, merged.ticks.Contains("ethi"))
+	assert.False(t, merged.ticks.Contains("other"))
+}
+
+// backfillState is the committed state shared by the fake repositories, entities are copied
+// in and out like a database would.
+type backfillState struct {
+	mutex    sync.Mutex
+	balances map[balance.BalanceKey]balance.Balance
+	loads    map[balance.BalanceKey]int
+	errCodes map[uint64][]int32
+}
+
+type fakeBackfillBalanceRepo struct {
+	balance.BalanceRepository
+	state *backfillState
+}
+
+func (f *fakeBackfillBalanceRepo) LoadMany(_ context.Context, keys []balance.BalanceKey) (map[balance.BalanceKey]*balance.Balance, error) {
+	f.state.mutex.Lock()
+	defer f.state.mutex.Unlock()
+
+	var result = make(map[balance.BalanceKey]*balance.Balance)
+	for _, key := range keys {
+		f.state.loads[key]++
+		if entity, ok := f.state.balances[key]; ok {
+			result[key] = &entity
+		}
+	}
+
+	return result, nil
+}
+
+func (f *fakeBackfillBalanceRepo) Save(_ context.Context, entities ...*balance.Balance) error {
+	f.state.mutex.Lock()
+	defer f.state.mutex.Unlock()
+
+	for _, entity := range entities {
+		f.state.balances[entity.Key()] = *entity
+	}
+	return nil
+}
+
+type fakeBackfillEventRepo struct {
+	domain.EventRepository
+	state *backfillState
+}
+
+func (f *fakeBackfillEventRepo) LoadMany(_ context.Context, _ []string) (map[string]domain.Event, error) {
+	return nil, nil
+}
+
+func (f *fakeBackfillEventRepo) Save(_ context.Context, event *domain.EventsByBlock) error {
+	f.state.mutex.Lock()
+	defer f.state.mutex.Unlock()
+
+	var codes []int32
+	for _, e := range event.Events {
+		codes = append(codes, e.GetErrCode())
+	}
+	f.state.errCodes[event.BlockNumber] = codes
+	return nil
+}
+
+type fakeBackfillTickRepo struct{ tick.TickRepository }
+
+func (fakeBackfillTickRepo) LoadMany(_ context.Context, names []string) (map[string]tick.Tick, error) {
+	var result = make(map[string]tick.Tick)
+	for _, name := range names {
+		result[name] = &tick.IERC20Tick{Tick: name}
+	}
+	return result, nil
+}
+
+func (fakeBackfillTickRepo) Save(_ context.Context, _ ...tick.Tick) error { return nil }
+
+type fakeBackfillStakingRepo struct{ staking.StakingRepository }
+
+func (fakeBackfillStakingRepo) LoadAllPools(_ context.Context) (map[string]*staking.PoolAggregate, error) {
+	return nil, nil
+}
+
+func (fakeBackfillStakingRepo) Save(_ context.Context, _ uint64, _ ...*staking.PoolAggregate) error {
+	return nil
+}
+
+type fakeBackfillTransactionRepo struct{}
+
+func (fakeBackfillTransactionRepo) TransactionSave(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeBackfillTransactionRepo) UpdateCache(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeBackfillBlockRepo struct{ domain.BlockRepository }
+
+func (fakeBackfillBlockRepo) Update(_ context.Context, _ *domain.Block) error { return nil }
+
+type fakeBackfillOutboxRepo struct{ outbox.Repository }
+
+func (fakeBackfillOutboxRepo) Save(_ context.Context, _ *domain.EventsByBlock) error { return nil }
+
+type fakeBackfillListingRepo struct{ market.ListingRepository }
+
+func (fakeBackfillListingRepo) Save(_ context.Context, _ *market.ListingUpdates) error { return nil }
+
+type fakeBackfillStatsRepo struct{ market.StatsRepository }
+
+func (fakeBackfillStatsRepo) Save(_ context.Context, _ ...*market.TickStats) error { return nil }
+
+func newBackfillService() (*BlockService, *backfillState) {
+	state := &backfillState{
+		balances: map[balance.BalanceKey]balance.Balance{
+			balance.NewBalanceKey(backfillAlice, "ethi"): {Address: backfillAlice, Tick: "ethi", Available: decimal.NewFromInt(100), Freeze: decimal.Zero, MintedAmount: decimal.Zero},
+		},
+		loads:    make(map[balance.BalanceKey]int),
+		errCodes: make(map[uint64][]int32),
+	}
+
+	return &BlockService{
+		logger:           log.NewHelper(log.DefaultLogger),
+		blockRepo:        fakeBackfillBlockRepo{},
+		eventRepo:        &fakeBackfillEventRepo{state: state},
+		transactionRepo:  fakeBackfillTransactionRepo{},
+		tickRepo:         fakeBackfillTickRepo{},
+		balanceRepo:      &fakeBackfillBalanceRepo{state: state},
+		stakingRepo:      fakeBackfillStakingRepo{},
+		outboxRepo:       fakeBackfillOutboxRepo{},
+		listingRepo:      fakeBackfillListingRepo{},
+		statsRepo:        fakeBackfillStatsRepo{},
+		journalRetention: defaultMaxReorgDepth,
+	}, state
+}
+
+const (
+	backfillAlice = "0x0000000000000000000000000000000000000001"
+	backfillBob   = "0x0000000000000000000000000000000000000002"
+	backfillCarol = "0x0000000000000000000000000000000000000003"
+)
+
+// newBackfillBlocks returns two blocks, the second spends the balance received in the first.
+func newBackfillBlocks() []*domain.Block {
+	newTransfer := func(number uint64, from, to string, amount int64) *domain.Block {
+		command := &protocol.TransferCommand{
+			IERCTransactionBase: protocol.IERCTransactionBase{
+				BlockNumber: number,
+				TxHash:      from,
+				From:        from,
+				To:          protocol.ZeroAddress,
+				Protocol:    protocol.ProtocolIERC20,
+				Operate:     protocol.OpTransfer,
+			},
+			Records: []*protocol.TransferRecord{
+				{Protocol: protocol.ProtocolIERC20, Operate: protocol.OpTransfer, Tick: "ethi", From: from, Recv: to, Amount: decimal.NewFromInt(amount)},
+			},
+		}
+
+		return &domain.Block{
+			Number:       number,
+			Transactions: []*domain.Transaction{{BlockNumber: number, Hash: from, From: from, IERCTransaction: command}},
+		}
+	}
+
+	return []*domain.Block{
+		newTransfer(10, backfillAlice, backfillBob, 60),
+		newTransfer(11, backfillBob, backfillCarol, 50),
+	}
+}
+
+func TestHandleBlocks(t *testing.T) {
+	ctx := context.Background()
+
+	sequential, sequentialState := newBackfillService()
+	for _, block := range newBackfillBlocks() {
+		assert.NoError(t, sequential.HandleBlock(ctx, block))
+	}
+
+	parallel, parallelState := newBackfillService()
+	assert.NoError(t, parallel.HandleBlocks(ctx, newBackfillBlocks()))
+
+	// block 11 read the balance of bob before block 10 was committed, it is preprocessed again.
+	bob := balance.NewBalanceKey(backfillBob, "ethi")
+	assert.Equal(t, 2, sequentialState.loads[bob])
+	assert.Equal(t, 3, parallelState.loads[bob])
+
+	assert.Equal(t, []int32{0}, parallelState.errCodes[11])
+	assert.Equal(t, sequentialState.errCodes, parallelState.errCodes)
+	assert.Equal(t, uint64(11), parallel.GetLastHandleBlock())
+
+	for key, expected := range sequentialState.balances {
+		actual, ok := parallelState.balances[key]
+		assert.True(t, ok)
+		assert.Equal(t, expected.Available.String(), actual.Available.String(), key)
+	}
+	assert.Equal(t, "50", parallelState.balances[balance.NewBalanceKey(backfillCarol, "ethi")].Available.String())
+}
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/IErcOrg/IERC_Indexer/internal/conf"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/market"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/tick"
@@ -15,18 +18,26 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// journalPruneInterval is the number of blocks between two prunes of the state journals.
+const journalPruneInterval = 100
+
 type BlockService struct {
 	logger          *log.Helper
 	blockRepo       domain.BlockRepository
 	eventRepo       domain.EventRepository
 	transactionRepo domain.TransactionRepository
+	journalRepo     domain.JournalRepository
 	tickRepo        tick.TickRepository
 	balanceRepo     balance.BalanceRepository
 	stakingRepo     staking.StakingRepository
+	outboxRepo      outbox.Repository
+	listingRepo     market.ListingRepository
+	statsRepo       market.StatsRepository
 
 	// config
-	invalidHashMap map[string]struct{}
-	feeStartBlock  uint64
+	invalidHashMap   map[string]struct{}
+	feeStartBlock    uint64
+	journalRetention uint64
 
 	// runtime
 	lastHandleBlock uint64
@@ -38,26 +49,43 @@ func NewBlockService(
 	blockRepo domain.BlockRepository,
 	eventRepo domain.EventRepository,
 	transactionRepo domain.TransactionRepository,
+	journalRepo domain.JournalRepository,
 	tickRepo tick.TickRepository,
 	balanceRepo balance.BalanceRepository,
 	stakingRepo staking.StakingRepository,
+	outboxRepo outbox.Repository,
+	listingRepo market.ListingRepository,
+	statsRepo market.StatsRepository,
 ) (*BlockService, error) {
 	lastBlock, err := eventRepo.GetBlockNumberByLastEvent(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
+	journalRetention := c.Runtime.GetJournalRetention()
+	if journalRetention == 0 {
+		journalRetention = c.Runtime.GetMaxReorgDepth()
+	}
+	if journalRetention == 0 {
+		journalRetention = defaultMaxReorgDepth
+	}
+
 	return &BlockService{
-		logger:          log.NewHelper(log.With(logger, "module", "BlockService")),
-		blockRepo:       blockRepo,
-		eventRepo:       eventRepo,
-		transactionRepo: transactionRepo,
-		tickRepo:        tickRepo,
-		balanceRepo:     balanceRepo,
-		stakingRepo:     stakingRepo,
-		invalidHashMap:  c.InvalidTxHash,
-		feeStartBlock:   c.Runtime.GetFeeStartBlock(),
-		lastHandleBlock: lastBlock,
+		logger:           log.NewHelper(log.With(logger, "module", "BlockService")),
+		blockRepo:        blockRepo,
+		eventRepo:        eventRepo,
+		transactionRepo:  transactionRepo,
+		journalRepo:      journalRepo,
+		tickRepo:         tickRepo,
+		balanceRepo:      balanceRepo,
+		stakingRepo:      stakingRepo,
+		outboxRepo:       outboxRepo,
+		listingRepo:      listingRepo,
+		statsRepo:        statsRepo,
+		invalidHashMap:   c.InvalidTxHash,
+		feeStartBlock:    c.Runtime.GetFeeStartBlock(),
+		journalRetention: journalRetention,
+		lastHandleBlock:  lastBlock,
 	}, nil
 }
 
@@ -79,42 +107,184 @@ func (b *BlockService) HandleBlock(ctx context.Context, block *domain.Block) err
 		b.logger.Infof("handle block done. block_number: %d, events: %d, duration: %v", block.Number, eventCount, time.Since(start))
 	}()
 
-	aggregate, err := b.preprocessing(ctx, block)
+	aggregate, _, err := b.preprocessing(ctx, block)
 	if err != nil {
 		return err
 	}
 
+	if err := b.commit(ctx, aggregate); err != nil {
+		return err
+	}
+
+	eventCount = len(aggregate.Events)
+	return nil
+}
+
+// commit handles the preprocessed block on top of the committed state and saves it.
+// it must be called in block order.
+func (b *BlockService) commit(ctx context.Context, aggregate *domain.AggregateRoot) error {
+
+	pools, err := b.stakingRepo.LoadAllPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	aggregate.PreviousBlock = b.lastHandleBlock
+	aggregate.StakingPools = pools
+
+	holding := market.HoldingKeys(aggregate.BalancesMap)
 	aggregate.Handle()
+	stats := market.NewBlockStats(aggregate.Block.Number, aggregate.Events, holding, aggregate.BalancesMap)
 
-	if err := b.saveToDBWithTx(ctx, aggregate); err != nil {
+	if err := b.saveToDBWithTx(ctx, aggregate, stats); err != nil {
 		return err
 	}
 
-	eventCount = len(aggregate.Events)
 	if len(aggregate.Events) != 0 {
 		b.lastHandleBlock = aggregate.Block.Number
 	}
 
+	if number := aggregate.Block.Number; number%journalPruneInterval == 0 && number > b.journalRetention {
+		// the journals only serve rollbacks, a failed prune is retried on the next interval.
+		if err := b.journalRepo.PruneJournals(ctx, number-b.journalRetention); err != nil {
+			b.logger.Warnf("prune state journals failed. block_number: %d, err: %s", number-b.journalRetention, err)
+		}
+	}
+
 	return nil
 }
 
-func (b *BlockService) preprocessing(ctx context.Context, block *domain.Block) (*domain.AggregateRoot, error) {
+// Rollback revokes the blocks above blockNumber and restores the state to blockNumber.
+func (b *BlockService) Rollback(ctx context.Context, blockNumber uint64) error {
+	b.logger.Infof("start rollback block. block_number: %d", blockNumber)
+	return b.rollback(ctx, blockNumber, b.blockRepo.Rollback)
+}
 
-	var (
-		aggregate = domain.NewBlockAggregate(b.lastHandleBlock, block, b.invalidHashMap, b.feeStartBlock)
+// RollbackToHeight restores the state to blockNumber and marks the blocks above it as unprocessed,
+// so that they are handled again.
+func (b *BlockService) RollbackToHeight(ctx context.Context, blockNumber uint64) error {
+	b.logger.Infof("start rollback to height. block_number: %d", blockNumber)
+	return b.rollback(ctx, blockNumber, b.blockRepo.Reset)
+}
 
-		tickSet         = mapset.NewSet[string]()
-		balanceSet      = mapset.NewSet[balance.BalanceKey]()
-		signatureSet    = mapset.NewSet[string]()
-		unfreezeSignSet = mapset.NewSet[string]()
-	)
+func (b *BlockService) rollback(ctx context.Context, blockNumber uint64, resetBlocks func(ctx context.Context, blockNumber uint64) error) error {
 
-	pools, err := b.stakingRepo.LoadAllPools(ctx)
+	if err := b.checkJournal(ctx, blockNumber); err != nil {
+		return err
+	}
+
+	err := b.transactionRepo.TransactionSave(ctx, func(ctxWithTx context.Context) error {
+		if err := resetBlocks(ctxWithTx, blockNumber); err != nil {
+			return err
+		}
+
+		// the ticks of the revoked events are read before the events are deleted.
+		if err := b.outboxRepo.Rollback(ctxWithTx, blockNumber); err != nil {
+			return err
+		}
+
+		if err := b.eventRepo.Rollback(ctxWithTx, blockNumber); err != nil {
+			return err
+		}
+
+		if err := b.tickRepo.Rollback(ctxWithTx, blockNumber); err != nil {
+			return err
+		}
+
+		if err := b.balanceRepo.Rollback(ctxWithTx, blockNumber); err != nil {
+			return err
+		}
+
+		if err := b.stakingRepo.Rollback(ctxWithTx, blockNumber); err != nil {
+			return err
+		}
+
+		if err := b.listingRepo.Rollback(ctxWithTx, blockNumber); err != nil {
+			return err
+		}
+
+		// the snapshots of the statistics are taken again from the events and balances rolled back.
+		if err := b.statsRepo.Rollback(ctxWithTx, blockNumber); err != nil {
+			return err
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	aggregate.StakingPools = pools
+	err = b.transactionRepo.UpdateCache(ctx, func(ctxWithUpdateKind context.Context) error {
+		if err := b.tickRepo.Rollback(ctxWithUpdateKind, blockNumber); err != nil {
+			return err
+		}
+
+		if err := b.balanceRepo.Rollback(ctxWithUpdateKind, blockNumber); err != nil {
+			return err
+		}
+
+		if err := b.stakingRepo.Rollback(ctxWithUpdateKind, blockNumber); err != nil {
+			return err
+		}
+
+		return b.eventRepo.Rollback(ctxWithUpdateKind, blockNumber)
+	})
+	if err != nil {
+		return err
+	}
+
+	lastBlock, err := b.eventRepo.GetBlockNumberByLastEvent(ctx)
+	if err != nil {
+		return err
+	}
+
+	b.lastHandleBlock = lastBlock
+	return nil
+}
+
+// checkJournal makes sure the state changes above blockNumber were all journaled.
+func (b *BlockService) checkJournal(ctx context.Context, blockNumber uint64) error {
+	blocks, err := b.eventRepo.QueryEventsByBlocks(ctx, blockNumber, 1)
+	if err != nil {
+		return err
+	}
+
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	firstJournalBlock, err := b.journalRepo.GetFirstJournalBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	if firstJournalBlock == 0 || blocks[0].BlockNumber < firstJournalBlock {
+		// the journals below the retention are pruned, nothing was journaled when there are none.
+		oldest := b.lastHandleBlock
+		if firstJournalBlock != 0 {
+			oldest = firstJournalBlock - 1
+		}
+
+		return fmt.Errorf("state journal not available. block_number: %d, first_event_block: %d, oldest_rollback_height: %d",
+			blockNumber, blocks[0].BlockNumber, oldest)
+	}
+
+	return nil
+}
+
+// preprocessing loads the state read by the block. it returns the keys of the state it read,
+// staking pools are loaded on commit.
+func (b *BlockService) preprocessing(ctx context.Context, block *domain.Block) (*domain.AggregateRoot, *stateKeys, error) {
+
+	var (
+		aggregate = domain.NewBlockAggregate(b.lastHandleBlock, block, b.invalidHashMap, b.feeStartBlock)
+		reads     = newStateKeys()
+
+		tickSet         = reads.ticks
+		balanceSet      = reads.balances
+		signatureSet    = reads.signatures
+		unfreezeSignSet = mapset.NewSet[string]()
+	)
 
 loop:
 	for _, transaction := range block.Transactions {
@@ -228,14 +398,14 @@ loop:
 	})
 
 	if err := eg.Wait(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if err := b.loadUnfreezeEventRelatedData(ctx, aggregate, unfreezeSignSet); err != nil {
-		return nil, err
+	if err := b.loadUnfreezeEventRelatedData(ctx, aggregate, reads, unfreezeSignSet); err != nil {
+		return nil, nil, err
 	}
 
-	return aggregate, nil
+	return aggregate, reads, nil
 }
 
 func (b *BlockService) loadTicks(ctx context.Context, root *domain.AggregateRoot, names []string) error {
@@ -253,16 +423,12 @@ func (b *BlockService) loadTicks(ctx context.Context, root *domain.AggregateRoot
 		return nil
 	}
 
-	for _, tickName := range queryTicks {
-		entity, err := b.tickRepo.Load(ctx, tickName)
-		if err != nil {
-			return err // database error
-		}
-
-		if entity == nil {
-			continue
-		}
+	entities, err := b.tickRepo.LoadMany(ctx, queryTicks)
+	if err != nil {
+		return err // database error
+	}
 
+	for _, entity := range entities {
 		root.TicksMap[entity.GetName()] = entity
 	}
 
@@ -285,16 +451,12 @@ func (b *BlockService) loadBalances(ctx context.Context, root *domain.AggregateR
 		return nil
 	}
 
-	for _, key := range queries {
-		entity, err := b.balanceRepo.Load(ctx, key)
-		if err != nil {
-			return err
-		}
-
-		if entity == nil {
-			continue
-		}
+	entities, err := b.balanceRepo.LoadMany(ctx, queries)
+	if err != nil {
+		return err
+	}
 
+	for _, entity := range entities {
 		root.BalancesMap[entity.Key()] = entity
 	}
 
@@ -302,7 +464,7 @@ func (b *BlockService) loadBalances(ctx context.Context, root *domain.AggregateR
 }
 
 func (b *BlockService) loadEventsBySignature(ctx context.Context, root *domain.AggregateRoot, signs []string) error {
-	signatures, err := b.eventRepo.QueryEventBySignature(ctx, signs)
+	signatures, err := b.eventRepo.LoadMany(ctx, signs)
 	if err != nil {
 		return err
 	}
@@ -320,7 +482,7 @@ func (b *BlockService) loadEventsBySignature(ctx context.Context, root *domain.A
 	return nil
 }
 
-func (b *BlockService) loadUnfreezeEventRelatedData(ctx context.Context, root *domain.AggregateRoot, unfreezeSignSet mapset.Set[string]) error {
+func (b *BlockService) loadUnfreezeEventRelatedData(ctx context.Context, root *domain.AggregateRoot, reads *stateKeys, unfreezeSignSet mapset.Set[string]) error {
 
 	var (
 		tickSet    = mapset.NewSet[string]()
@@ -337,12 +499,14 @@ func (b *BlockService) loadUnfreezeEventRelatedData(ctx context.Context, root *d
 			continue
 		}
 
+		reads.ticks.Add(e.Data.Tick)
 		_, existed := root.TicksMap[e.Data.Tick]
 		if !existed {
 			tickSet.Add(e.Data.Tick)
 		}
 
 		key := balance.NewBalanceKey(e.Data.From, e.Data.Tick)
+		reads.balances.Add(key)
 		_, existed = root.BalancesMap[key]
 		if !existed {
 			balanceSet.Add(key)
@@ -365,40 +529,27 @@ func (b *BlockService) loadUnfreezeEventRelatedData(ctx context.Context, root *d
 	return eg.Wait()
 }
 
-func (b *BlockService) saveToDBWithTx(ctx context.Context, root *domain.AggregateRoot) error {
+func (b *BlockService) saveToDBWithTx(ctx context.Context, root *domain.AggregateRoot, stats []*market.TickStats) error {
 
 	var (
-		needUpdateTicks    = make([]tick.Tick, 0, len(root.TicksMap))
-		needUpdateBalances = make([]*balance.Balance, 0, len(root.BalancesMap))
-		pools              = poolsMapToSlice(root.StakingPools)
+		needUpdateTicks, needUpdateBalances = changedEntities(root)
+		pools                               = poolsMapToSlice(root.StakingPools)
+		event                               = &domain.EventsByBlock{BlockNumber: root.Block.Number, Events: root.Events}
 	)
 
-	for _, entity := range root.TicksMap {
-		if entity.LastUpdatedBlock() < root.Block.Number {
-			continue
-		}
-
-		needUpdateTicks = append(needUpdateTicks, entity)
-	}
-
-	for _, entity := range root.BalancesMap {
-		if entity.LastUpdatedBlock < root.Block.Number {
-			continue
-		}
-
-		needUpdateBalances = append(needUpdateBalances, entity)
-	}
-
 	err := b.transactionRepo.TransactionSave(ctx, func(ctxWithTx context.Context) error {
 		if err := b.blockRepo.Update(ctxWithTx, root.Block); err != nil {
 			return err
 		}
 
-		event := &domain.EventsByBlock{BlockNumber: root.Block.Number, Events: root.Events}
 		if err := b.eventRepo.Save(ctxWithTx, event); err != nil {
 			return err
 		}
 
+		if err := b.outboxRepo.Save(ctxWithTx, event); err != nil {
+			return err
+		}
+
 		if err := b.tickRepo.Save(ctxWithTx, needUpdateTicks...); err != nil {
 			return err
 		}
@@ -411,6 +562,14 @@ func (b *BlockService) saveToDBWithTx(ctx context.Context, root *domain.Aggregat
 			return err
 		}
 
+		if err := b.listingRepo.Save(ctxWithTx, market.NewListingUpdates(root.Events)); err != nil {
+			return err
+		}
+
+		if err := b.statsRepo.Save(ctxWithTx, stats...); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -421,10 +580,36 @@ func (b *BlockService) saveToDBWithTx(ctx context.Context, root *domain.Aggregat
 		_ = b.tickRepo.Save(ctxWithUpdateKind, needUpdateTicks...)
 		_ = b.balanceRepo.Save(ctxWithUpdateKind, needUpdateBalances...)
 		_ = b.stakingRepo.Save(ctxWithUpdateKind, root.Block.Number, pools...)
-		return nil
+		return b.eventRepo.Save(ctxWithUpdateKind, event)
 	})
 }
 
+// changedEntities returns the ticks and balances updated by the block.
+func changedEntities(root *domain.AggregateRoot) ([]tick.Tick, []*balance.Balance) {
+	var (
+		ticks    = make([]tick.Tick, 0, len(root.TicksMap))
+		balances = make([]*balance.Balance, 0, len(root.BalancesMap))
+	)
+
+	for _, entity := range root.TicksMap {
+		if entity.LastUpdatedBlock() < root.Block.Number {
+			continue
+		}
+
+		ticks = append(ticks, entity)
+	}
+
+	for _, entity := range root.BalancesMap {
+		if entity.LastUpdatedBlock < root.Block.Number {
+			continue
+		}
+
+		balances = append(balances, entity)
+	}
+
+	return ticks, balances
+}
+
 func poolsMapToSlice(poolsMap map[string]*staking.PoolAggregate) []*staking.PoolAggregate {
 	var result = make([]*staking.PoolAggregate, 0, len(poolsMap))
 	for _, root := range poolsMap {
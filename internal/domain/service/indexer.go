@@ -2,9 +2,9 @@ package service
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/IErcOrg/IERC_Indexer/internal/conf"
@@ -14,6 +14,13 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+const defaultMaxReorgDepth = 64
+
+type pendingBlock struct {
+	reorgSeq uint64
+	block    *domain.Block
+}
+
 type IndexDomainService struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -25,11 +32,17 @@ type IndexDomainService struct {
 
 	enableSync     bool
 	syncStartBlock uint64
-	syncThreadsNum uint64
+	syncBlockTag   domain.BlockTag
+	confirmations  uint64
+	maxReorgDepth  uint64
 
 	enableHandle   bool
 	handleEndBlock uint64
-	handleQueue    chan *domain.Block
+	parallelBlocks int
+	handleQueue    chan *pendingBlock
+	handleMutex    sync.Mutex
+	reorgSeq       atomic.Uint64
+	syncedNotify   chan struct{}
 
 	invalidHashMap map[string]struct{}
 	feeStartBlock  uint64
@@ -44,11 +57,21 @@ func NewIndexApplication(
 	fetcher domain.BlockFetcher,
 	blockRepo domain.BlockRepository,
 	handler *BlockService,
-) *IndexDomainService {
+) (*IndexDomainService, error) {
+
+	syncBlockTag, err := domain.ParseBlockTag(data.Runtime.GetSyncBlockTag())
+	if err != nil {
+		return nil, err
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	eg, gCtx := errgroup.WithContext(ctx)
 
+	maxReorgDepth := data.Runtime.GetMaxReorgDepth()
+	if maxReorgDepth == 0 {
+		maxReorgDepth = defaultMaxReorgDepth
+	}
+
 	return &IndexDomainService{
 		ctx:            gCtx,
 		cancel:         cancel,
@@ -58,15 +81,19 @@ func NewIndexApplication(
 		handler:        handler,
 		enableSync:     data.Runtime.EnableSync,
 		syncStartBlock: data.Runtime.SyncStartBlock,
-		syncThreadsNum: max(data.Runtime.SyncThreadsNum, 1),
+		syncBlockTag:   syncBlockTag,
+		confirmations:  data.Runtime.GetSyncConfirmations(),
+		maxReorgDepth:  maxReorgDepth,
 		enableHandle:   data.Runtime.EnableHandle,
 		handleEndBlock: data.Runtime.HandleEndBlock,
-		handleQueue:    make(chan *domain.Block, data.Runtime.HandleQueueSize),
+		parallelBlocks: int(max(data.Runtime.GetHandleParallelBlocks(), 1)),
+		handleQueue:    make(chan *pendingBlock, data.Runtime.HandleQueueSize),
+		syncedNotify:   make(chan struct{}, 1),
 		invalidHashMap: data.InvalidTxHash,
 		feeStartBlock:  data.Runtime.GetFeeStartBlock(),
 		status:         new(domain.BlockHandleStatus),
 		log:            log,
-	}
+	}, nil
 }
 
 func (srv *IndexDomainService) Start(_ context.Context) error {
@@ -124,7 +151,7 @@ func (srv *IndexDomainService) initStatus() error {
 	eg, gCtx := errgroup.WithContext(srv.ctx)
 
 	eg.Go(func() error {
-		latestBlock, err := srv.fetcher.GetBlockHeaderByNumber(gCtx, 0)
+		latestBlock, err := srv.fetchLatestBlock(gCtx)
 		if err != nil {
 			return err
 		}
@@ -173,6 +200,14 @@ func (srv *IndexDomainService) syncBlockLoop() error {
 	helper.Info("start sync block loop")
 	defer helper.Info("quit sync block loop")
 
+	ctx, cancel := context.WithCancel(srv.ctx)
+	defer cancel()
+
+	newHeads, err := srv.fetcher.SubscribeNewHead(ctx)
+	if err != nil {
+		helper.Warnf("subscribe new head failed, fall back to polling. err: %s", err)
+	}
+
 	for {
 		select {
 		case <-srv.ctx.Done():
@@ -189,12 +224,12 @@ func (srv *IndexDomainService) syncBlockLoop() error {
 
 			var (
 				indexStartNumber = status.LastIndexedBlock.Number + 1
-				indexEndNumber   = min(status.LatestBlock.Number, indexStartNumber+srv.syncThreadsNum)
+				indexEndNumber   = min(status.LatestBlock.Number, indexStartNumber+max(srv.fetcher.RangeSize(), 1))
 				size             = indexEndNumber - indexStartNumber
 			)
 
 			helper.Infof("fetch block. start_height: %d, end_height: %d, size: %d", indexStartNumber, indexEndNumber, size)
-			blocks, err := srv.fetchBlocks(srv.ctx, indexStartNumber, size)
+			blocks, err := srv.fetcher.GetBlocksByRange(srv.ctx, indexStartNumber, size)
 			if err != nil {
 				helper.Errorf("fetch blocks failed. err: %s", err)
 				return err
@@ -204,34 +239,54 @@ func (srv *IndexDomainService) syncBlockLoop() error {
 				continue
 			}
 
-			lastIndexedBlock := status.LastIndexedBlock
+			var (
+				lastIndexedBlock = status.LastIndexedBlock
+				reorged          bool
+			)
 
-			for _, block := range blocks {
+			for idx, block := range blocks {
 				if lastIndexedBlock != nil && block.ParentHash != lastIndexedBlock.Hash {
-					helper.Errorf("block data error. lastIndexedHash: %s, parentHash: %s", lastIndexedBlock.Hash, block.ParentHash)
-					return errors.New("block rollback")
+					helper.Warnf("block reorg. lastIndexedHash: %s, parentHash: %s, number: %d", lastIndexedBlock.Hash, block.ParentHash, block.Number)
+					blocks, reorged = blocks[:idx], true
+					break
 				}
 
 				lastIndexedBlock = block.Header()
 			}
 
-			if err = srv.blockRepo.BulkSaveBlock(srv.ctx, blocks); err != nil {
-				return err
+			if len(blocks) != 0 {
+				if err = srv.blockRepo.BulkSaveBlock(srv.ctx, blocks); err != nil {
+					return err
+				}
+
+				status.LastIndexedBlock = lastIndexedBlock
+				srv.notifySynced()
 			}
 
-			status.LastIndexedBlock = lastIndexedBlock
+			if reorged {
+				if err = srv.handleReorg(srv.ctx); err != nil {
+					helper.Errorf("handle reorg failed. err: %s", err)
+					return err
+				}
+			}
 
 		default:
 
-			latestBlock, err := srv.fetcher.GetBlockHeaderByNumber(srv.ctx, 0)
+			latestBlock, err := srv.fetchLatestBlock(srv.ctx)
 			if err != nil {
 				return err
 			}
 
 			switch {
 			case latestBlock.Number == status.LatestBlock.Number:
-				helper.Info("There is no latest block, please update after 10 seconds.")
-				time.Sleep(time.Second * 10)
+				helper.Info("There is no latest block, wait for new head or 10 seconds.")
+				timer := time.NewTimer(time.Second * 10)
+				select {
+				case <-srv.ctx.Done():
+				case <-newHeads:
+				case <-timer.C:
+				}
+				timer.Stop()
 
 			case latestBlock.Number > status.LatestBlock.Number:
 				helper.Infof("fetch latest block number. latest_block_number: %s", latestBlock)
@@ -244,45 +299,82 @@ func (srv *IndexDomainService) syncBlockLoop() error {
 	}
 }
 
-func (srv *IndexDomainService) fetchBlocks(ctx context.Context, startAt uint64, size uint64) ([]*domain.Block, error) {
+// fetchLatestBlock returns the head block followed by the sync loop, it is the block of sync_block_tag
+// minus sync_confirmations, so that only blocks unlikely to reorg are saved.
+func (srv *IndexDomainService) fetchLatestBlock(ctx context.Context) (*domain.BlockHeader, error) {
+	header, err := srv.fetcher.GetBlockHeaderByTag(ctx, srv.syncBlockTag)
+	if err != nil {
+		return nil, err
+	}
 
-	var (
-		gg, gCtx   = errgroup.WithContext(ctx)
-		bufferChan = make(chan *domain.Block, size)
-	)
+	if srv.confirmations == 0 || header.Number <= srv.confirmations {
+		return header, nil
+	}
 
-	gg.SetLimit(1000)
+	return srv.fetcher.GetBlockHeaderByNumber(ctx, header.Number-srv.confirmations)
+}
 
-	for i := uint64(0); i < size; i++ {
-		targetBlock := startAt + i
-		gg.Go(func() error {
-			block, err := srv.fetcher.GetBlockByNumber(gCtx, targetBlock)
-			if err != nil {
-				return err
-			}
+// notifySynced wakes the load loop after new blocks are saved.
+func (srv *IndexDomainService) notifySynced() {
+	select {
+	case srv.syncedNotify <- struct{}{}:
+	default:
+	}
+}
 
-			bufferChan <- block
-			return nil
-		})
+func (srv *IndexDomainService) handleReorg(ctx context.Context) error {
+	helper := log.NewHelper(log.With(srv.log, "method", "HandleReorg"))
+
+	ancestor, err := srv.findCommonAncestor(ctx, srv.status.LastIndexedBlock.Number)
+	if err != nil {
+		return err
 	}
 
-	go func() {
-		_ = gg.Wait()
-		close(bufferChan)
-	}()
+	helper.Infof("rollback to common ancestor. indexed_block: %s, ancestor: %d, hash: %s", srv.status.LastIndexedBlock, ancestor.Number, ancestor.Hash)
+
+	srv.handleMutex.Lock()
+	defer srv.handleMutex.Unlock()
 
-	var blocks = make([]*domain.Block, 0, size)
-	for block := range bufferChan {
-		blocks = append(blocks, block)
+	if err := srv.handler.Rollback(ctx, ancestor.Number); err != nil {
+		return err
 	}
 
-	if err := gg.Wait(); err != nil {
-		return nil, err
+	syncBlock, err := srv.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil {
+		return err
 	}
 
-	sort.Slice(blocks, func(i, j int) bool { return blocks[i].Number < blocks[j].Number })
+	srv.status.LastIndexedBlock = ancestor
+	srv.status.LastSyncBlock = syncBlock
+	srv.reorgSeq.Add(1)
+
+	return nil
+}
+
+func (srv *IndexDomainService) findCommonAncestor(ctx context.Context, startAt uint64) (*domain.BlockHeader, error) {
+
+	for number := startAt; number > 0 && startAt-number < srv.maxReorgDepth; number-- {
+
+		local, err := srv.blockRepo.QueryBlockHeaderByNumber(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+
+		if local == nil {
+			return nil, fmt.Errorf("block not found. number: %d", number)
+		}
+
+		remote, err := srv.fetcher.GetBlockHeaderByNumber(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+
+		if local.Hash == remote.Hash {
+			return local, nil
+		}
+	}
 
-	return blocks, nil
+	return nil, fmt.Errorf("common ancestor not found. start: %d, max_depth: %d", startAt, srv.maxReorgDepth)
 }
 
 func (srv *IndexDomainService) loadBlockLoop() error {
@@ -290,7 +382,10 @@ func (srv *IndexDomainService) loadBlockLoop() error {
 	helper.Info("start block load loop")
 	defer helper.Info("stop block load loop")
 
-	var lastLoadNumber = uint64(0)
+	var (
+		lastLoadNumber = uint64(0)
+		lastReorgSeq   = srv.reorgSeq.Load()
+	)
 	if srv.status.LastSyncBlock != nil {
 		lastLoadNumber = srv.status.LastSyncBlock.Number
 	}
@@ -302,22 +397,32 @@ func (srv *IndexDomainService) loadBlockLoop() error {
 		default:
 		}
 
+		if reorgSeq := srv.reorgSeq.Load(); reorgSeq != lastReorgSeq {
+			lastReorgSeq = reorgSeq
+			lastLoadNumber = 0
+			if srv.status.LastSyncBlock != nil {
+				lastLoadNumber = srv.status.LastSyncBlock.Number
+			}
+			helper.Infof("block reorg, reload blocks. last_load_number: %d", lastLoadNumber)
+		}
+
 		blocks, err := srv.blockRepo.GetPendingBlocksWithTransactionsByNumber(srv.ctx, lastLoadNumber, 10)
 		if err != nil {
 			return err
 		}
 
 		if len(blocks) == 0 {
-			helper.Info("blocks is empty, wait 10 second")
-			ticker := time.NewTicker(time.Second * 10)
+			helper.Info("blocks is empty, wait for sync or 10 second")
+			timer := time.NewTimer(time.Second * 10)
 			select {
 			case <-srv.ctx.Done():
+				timer.Stop()
 				return nil
-			case <-ticker.C:
-				ticker.Stop()
-				ticker = nil
-				continue
+			case <-srv.syncedNotify:
+			case <-timer.C:
 			}
+			timer.Stop()
+			continue
 		}
 
 		for _, block := range blocks {
@@ -325,7 +430,7 @@ func (srv *IndexDomainService) loadBlockLoop() error {
 			select {
 			case <-srv.ctx.Done():
 				return nil
-			case srv.handleQueue <- block:
+			case srv.handleQueue <- &pendingBlock{reorgSeq: lastReorgSeq, block: block}:
 				//helper.Debugf("send block to handle queue, block number: %d", lastLoadNumber)
 			}
 		}
@@ -337,24 +442,77 @@ func (srv *IndexDomainService) handleBlockLoop() error {
 	helper.Info("start block handle loop")
 	defer helper.Info("stop block handle loop")
 
+	var next *pendingBlock
 	for {
-		select {
-		case <-srv.ctx.Done():
-			return nil
-
-		case block := <-srv.handleQueue:
+		pending := next
+		next = nil
 
-			if srv.handleEndBlock != 0 && block.Number > srv.handleEndBlock {
-				helper.Infof("block handle done. current_block: %d, end_block: %d", block.Number, srv.handleEndBlock)
+		if pending == nil {
+			select {
+			case <-srv.ctx.Done():
 				return nil
+			case pending = <-srv.handleQueue:
 			}
+		}
 
-			if err := srv.handler.HandleBlock(srv.ctx, block); err != nil {
-				helper.Errorf("handle block error: %s", err)
-				return err
+		// take up to parallelBlocks queued blocks of the same reorg sequence without waiting.
+		batch := []*pendingBlock{pending}
+	collect:
+		for len(batch) < srv.parallelBlocks {
+			select {
+			case item := <-srv.handleQueue:
+				if item.reorgSeq != pending.reorgSeq {
+					next = item
+					break collect
+				}
+				batch = append(batch, item)
+			default:
+				break collect
 			}
+		}
+
+		for idx, item := range batch {
+			if srv.handleEndBlock != 0 && item.block.Number > srv.handleEndBlock {
+				if err := srv.handleBlocks(batch[:idx]); err != nil {
+					helper.Errorf("handle block error: %s", err)
+					return err
+				}
+
+				helper.Infof("block handle done. current_block: %d, end_block: %d", item.block.Number, srv.handleEndBlock)
+				return nil
+			}
+		}
 
-			srv.status.LastSyncBlock = block.Header()
+		if err := srv.handleBlocks(batch); err != nil {
+			helper.Errorf("handle block error: %s", err)
+			return err
 		}
 	}
 }
+
+// handleBlocks handles consecutive blocks loaded in the same reorg sequence.
+func (srv *IndexDomainService) handleBlocks(batch []*pendingBlock) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	srv.handleMutex.Lock()
+	defer srv.handleMutex.Unlock()
+
+	// the blocks were loaded before a reorg, they may have been revoked.
+	if batch[0].reorgSeq != srv.reorgSeq.Load() {
+		return nil
+	}
+
+	blocks := make([]*domain.Block, 0, len(batch))
+	for _, item := range batch {
+		blocks = append(blocks, item.block)
+	}
+
+	if err := srv.handler.HandleBlocks(srv.ctx, blocks); err != nil {
+		return err
+	}
+
+	srv.status.LastSyncBlock = blocks[len(blocks)-1].Header()
+	return nil
+}
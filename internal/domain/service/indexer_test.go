@@ -1,11 +1,15 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestWithRetryCount(t *testing.T) {
@@ -38,3 +42,88 @@ func TestName(t *testing.T) {
 	}
 	spew.Dump(d)
 }
+
+type headerChain map[uint64]*domain.BlockHeader
+
+func newHeaderChain(start, end uint64, fork string) headerChain {
+	var chain = make(headerChain)
+	for number := start; number <= end; number++ {
+		chain[number] = &domain.BlockHeader{Number: number, Hash: fmt.Sprintf("%s%d", fork, number)}
+	}
+
+	return chain
+}
+
+type mockFetcher struct {
+	domain.BlockFetcher
+	chain headerChain
+}
+
+func (m *mockFetcher) GetBlockHeaderByNumber(_ context.Context, blockNumber uint64) (*domain.BlockHeader, error) {
+	return m.chain[blockNumber], nil
+}
+
+type mockBlockRepo struct {
+	domain.BlockRepository
+	chain headerChain
+}
+
+func (m *mockBlockRepo) QueryBlockHeaderByNumber(_ context.Context, blockNumber uint64) (*domain.BlockHeader, error) {
+	return m.chain[blockNumber], nil
+}
+
+func TestFindCommonAncestor(t *testing.T) {
+
+	var (
+		local  = newHeaderChain(100, 110, "0xa")
+		remote = newHeaderChain(100, 112, "0xa")
+	)
+
+	// blocks 108 ~ 112 are reorganized
+	for number, header := range newHeaderChain(108, 112, "0xb") {
+		remote[number] = header
+	}
+
+	srv := &IndexDomainService{
+		fetcher:       &mockFetcher{chain: remote},
+		blockRepo:     &mockBlockRepo{chain: local},
+		maxReorgDepth: defaultMaxReorgDepth,
+	}
+
+	ancestor, err := srv.findCommonAncestor(context.Background(), 110)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(107), ancestor.Number)
+	assert.Equal(t, "0xa107", ancestor.Hash)
+
+	srv.maxReorgDepth = 2
+	_, err = srv.findCommonAncestor(context.Background(), 110)
+	assert.Error(t, err)
+}
+
+func (m *mockFetcher) GetBlockHeaderByTag(_ context.Context, _ domain.BlockTag) (*domain.BlockHeader, error) {
+	var latest *domain.BlockHeader
+	for _, header := range m.chain {
+		if latest == nil || header.Number > latest.Number {
+			latest = header
+		}
+	}
+
+	return latest, nil
+}
+
+func TestFetchLatestBlockWithConfirmations(t *testing.T) {
+
+	srv := &IndexDomainService{
+		fetcher:      &mockFetcher{chain: newHeaderChain(100, 120, "0xa")},
+		syncBlockTag: domain.BlockTagLatest,
+	}
+
+	latest, err := srv.fetchLatestBlock(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(120), latest.Number)
+
+	srv.confirmations = 12
+	latest, err = srv.fetchLatestBlock(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(108), latest.Number)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	outboxBatchSize    = 500
+	outboxPollInterval = 500 * time.Millisecond
+	outboxRetention    = 24 * time.Hour
+)
+
+// OutboxRelay ships the outbox to the broker in the order of the messages. the offset is committed
+// after the broker acknowledged a batch, the broker skips the messages of a batch stored before a crash.
+type OutboxRelay struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	eg     *errgroup.Group
+
+	repo   outbox.Repository
+	broker outbox.Broker
+
+	logger *log.Helper
+}
+
+// NewOutboxRelay returns the relay, it does nothing without a broker.
+func NewOutboxRelay(repo outbox.Repository, broker outbox.Broker, logger log.Logger) *OutboxRelay {
+	ctx, cancel := context.WithCancel(context.Background())
+	eg, gCtx := errgroup.WithContext(ctx)
+
+	return &OutboxRelay{
+		ctx:    gCtx,
+		cancel: cancel,
+		eg:     eg,
+		repo:   repo,
+		broker: broker,
+		logger: log.NewHelper(log.With(logger, "module", "OutboxRelay")),
+	}
+}
+
+func (r *OutboxRelay) Start(_ context.Context) error {
+	if r.broker == nil {
+		return nil
+	}
+
+	r.logger.Info("start outbox relay")
+	defer r.logger.Info("quit outbox relay...")
+
+	r.eg.Go(utils.WithRetryCount(5, time.Second*15, time.Minute*3, r.relayLoop))
+	return r.eg.Wait()
+}
+
+func (r *OutboxRelay) Stop(_ context.Context) error {
+	r.cancel()
+	return r.eg.Wait()
+}
+
+func (r *OutboxRelay) relayLoop() error {
+	offset, err := r.repo.GetOffset(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+
+	var purgedAt time.Time
+	for {
+		for {
+			next, err := r.relay(r.ctx, offset)
+			if err != nil {
+				return err
+			}
+
+			if next == offset {
+				break
+			}
+			offset = next
+		}
+
+		if time.Since(purgedAt) > time.Hour {
+			if err := r.repo.Purge(r.ctx, offset, time.Now().Add(-outboxRetention)); err != nil {
+				return err
+			}
+			purgedAt = time.Now()
+		}
+
+		select {
+		case <-r.ctx.Done():
+			return r.ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay publishes a batch of messages after the offset, it returns the offset committed.
+func (r *OutboxRelay) relay(ctx context.Context, offset int64) (int64, error) {
+	messages, err := r.repo.Load(ctx, offset, outboxBatchSize)
+	if err != nil {
+		return offset, err
+	}
+
+	if len(messages) == 0 {
+		return offset, nil
+	}
+
+	if err := r.broker.Publish(ctx, messages); err != nil {
+		return offset, err
+	}
+
+	next := messages[len(messages)-1].ID
+	if err := r.repo.Commit(ctx, next); err != nil {
+		return offset, err
+	}
+
+	return next, nil
+}
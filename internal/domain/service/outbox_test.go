@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/memory"
+	"github.com/go-kratos/kratos/v2/log"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeOutboxRepo struct {
+	outbox.Repository
+	messages  []*outbox.Message
+	offset    int64
+	commitErr error
+}
+
+func (f *fakeOutboxRepo) add(block *domain.EventsByBlock) {
+	messages, _ := outbox.NewMessages(block)
+	for _, message := range messages {
+		f.messages = append(f.messages, message)
+		message.ID = int64(len(f.messages))
+	}
+}
+
+func (f *fakeOutboxRepo) Load(_ context.Context, offset int64, limit int) ([]*outbox.Message, error) {
+	var result []*outbox.Message
+	for _, message := range f.messages {
+		if message.ID > offset && len(result) < limit {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeOutboxRepo) Commit(_ context.Context, offset int64) error {
+	if err := f.commitErr; err != nil {
+		f.commitErr = nil
+		return err
+	}
+
+	f.offset = offset
+	return nil
+}
+
+func newOutboxTransfer(blockNumber uint64, tick string) domain.Event {
+	return &domain.IERC20TransferredEvent{
+		BlockNumber: blockNumber,
+		Data:        &domain.IERC20Transferred{Operate: protocol.OpTransfer, Tick: tick, From: "0x01", To: "0x02"},
+		EventAt:     time.Unix(1700000000, 0),
+	}
+}
+
+func TestOutboxRelay(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		repo   = &fakeOutboxRepo{}
+		broker = memory.NewBroker()
+		relay  = NewOutboxRelay(repo, broker, log.DefaultLogger)
+	)
+
+	repo.add(&domain.EventsByBlock{BlockNumber: 10, Events: []domain.Event{
+		newOutboxTransfer(10, "ethi"),
+		newOutboxTransfer(10, "usdt"),
+		newOutboxTransfer(10, "ethi"),
+	}})
+	repo.add(&domain.EventsByBlock{BlockNumber: 11, Events: []domain.Event{newOutboxTransfer(11, "ethi")}})
+
+	// the batch is published but the offset is not committed, as on a crash.
+	repo.commitErr = errors.New("connection lost")
+	offset, err := relay.relay(ctx, 0)
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), offset)
+
+	rollbacks, _ := outbox.NewRollbackMessages(10, []string{"ethi"})
+	repo.messages = append(repo.messages, rollbacks[0])
+	rollbacks[0].ID = int64(len(repo.messages))
+
+	offset, err = relay.relay(ctx, offset)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), offset)
+	assert.Equal(t, int64(4), repo.offset)
+
+	offset, err = relay.relay(ctx, offset)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), offset)
+
+	// every message is stored once, in order by tick.
+	ethi := broker.Messages("ethi")
+	assert.Len(t, ethi, 3)
+	assert.Len(t, broker.Messages("usdt"), 1)
+
+	var payloads []outbox.Payload
+	for _, message := range ethi {
+		var payload outbox.Payload
+		assert.NoError(t, jsoniter.Unmarshal(message.Payload, &payload))
+		payloads = append(payloads, payload)
+	}
+
+	assert.Equal(t, uint64(10), payloads[0].BlockNumber)
+	assert.Len(t, payloads[0].Events, 2)
+	assert.Equal(t, uint64(11), payloads[1].BlockNumber)
+	assert.True(t, payloads[2].Rollback)
+
+	event := domain.NewEventFromData(uint8(payloads[1].Events[0].Kind), payloads[1].Events[0].Event)
+	assert.Equal(t, "ethi", event.(*domain.IERC20TransferredEvent).Data.Tick)
+}
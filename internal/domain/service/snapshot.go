@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
+	"github.com/shopspring/decimal"
+)
+
+// SnapshotOptions selects the holders of a snapshot. BlockNumber 0 takes the last handled block,
+// holders with a total below MinTotal or in Exclude are left out.
+type SnapshotOptions struct {
+	Tick        string
+	BlockNumber uint64
+	MinTotal    decimal.Decimal
+	Exclude     map[string]struct{}
+}
+
+// Holder is the balance of an address in a snapshot.
+type Holder struct {
+	Address   string          `json:"address"`
+	Available decimal.Decimal `json:"available"`
+	Freeze    decimal.Decimal `json:"freeze"`
+	Staked    decimal.Decimal `json:"staked"`
+}
+
+func (h *Holder) Total() decimal.Decimal {
+	return h.Available.Add(h.Freeze).Add(h.Staked)
+}
+
+// Snapshot is the holders of a tick at the end of a block, the largest first.
+type Snapshot struct {
+	Tick        string
+	BlockNumber uint64
+	Holders     []*Holder
+}
+
+type SnapshotService struct {
+	blockRepo   domain.BlockRepository
+	balanceRepo balance.BalanceRepository
+	stakingRepo staking.StakingRepository
+}
+
+func NewSnapshotService(
+	blockRepo domain.BlockRepository,
+	balanceRepo balance.BalanceRepository,
+	stakingRepo staking.StakingRepository,
+) *SnapshotService {
+	return &SnapshotService{
+		blockRepo:   blockRepo,
+		balanceRepo: balanceRepo,
+		stakingRepo: stakingRepo,
+	}
+}
+
+func (s *SnapshotService) TakeSnapshot(ctx context.Context, opts SnapshotOptions) (*Snapshot, error) {
+	last, err := s.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastNumber uint64
+	if last != nil {
+		lastNumber = last.Number
+	}
+
+	blockNumber := opts.BlockNumber
+	if blockNumber == 0 {
+		blockNumber = lastNumber
+	}
+
+	if blockNumber > lastNumber {
+		return nil, fmt.Errorf("block %d is not handled yet, last handled block: %d", blockNumber, lastNumber)
+	}
+
+	balances, err := s.balanceRepo.QueryHoldersAt(ctx, opts.Tick, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	stakes, err := s.stakingRepo.QueryStakedBalancesAt(ctx, opts.Tick, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var holders = make(map[string]*Holder, len(balances))
+	getOrCreate := func(address string) *Holder {
+		holder, ok := holders[address]
+		if !ok {
+			holder = &Holder{Address: address}
+			holders[address] = holder
+		}
+		return holder
+	}
+
+	for _, b := range balances {
+		holder := getOrCreate(b.Address)
+		holder.Available = b.Available
+		holder.Freeze = b.Freeze
+	}
+
+	// the staked amount is frozen in the pool, move it back to the staker.
+	for _, stake := range stakes {
+		staker := getOrCreate(stake.Staker)
+		staker.Staked = staker.Staked.Add(stake.Amount)
+
+		pool := getOrCreate(stake.Pool)
+		pool.Freeze = pool.Freeze.Sub(stake.Amount)
+	}
+
+	for _, stake := range stakes {
+		if pool := holders[stake.Pool]; pool.Freeze.IsNegative() {
+			return nil, fmt.Errorf("frozen balance of pool %s is below its staked amount at block %d", stake.Pool, blockNumber)
+		}
+	}
+
+	var result = make([]*Holder, 0, len(holders))
+	for address, holder := range holders {
+		if _, ok := opts.Exclude[address]; ok {
+			continue
+		}
+
+		total := holder.Total()
+		if total.Sign() <= 0 || total.LessThan(opts.MinTotal) {
+			continue
+		}
+
+		result = append(result, holder)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if c := result[i].Total().Cmp(result[j].Total()); c != 0 {
+			return c > 0
+		}
+		return result[i].Address < result[j].Address
+	})
+
+	return &Snapshot{
+		Tick:        opts.Tick,
+		BlockNumber: blockNumber,
+		Holders:     result,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSnapshotBlockRepo struct {
+	domain.BlockRepository
+	last uint64
+}
+
+func (f *fakeSnapshotBlockRepo) GetLastHandleBlock(_ context.Context) (*domain.BlockHeader, error) {
+	return &domain.BlockHeader{Number: f.last}, nil
+}
+
+type fakeSnapshotBalanceRepo struct {
+	balance.BalanceRepository
+	balances []*balance.Balance
+}
+
+func (f *fakeSnapshotBalanceRepo) QueryHoldersAt(_ context.Context, _ string, _ uint64) ([]*balance.Balance, error) {
+	return f.balances, nil
+}
+
+type fakeSnapshotStakingRepo struct {
+	staking.StakingRepository
+	stakes []*staking.StakedBalance
+}
+
+func (f *fakeSnapshotStakingRepo) QueryStakedBalancesAt(_ context.Context, _ string, _ uint64) ([]*staking.StakedBalance, error) {
+	return f.stakes, nil
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	newBalance := func(address string, available, freeze int64) *balance.Balance {
+		return &balance.Balance{Address: address, Tick: "ethi", Available: decimal.NewFromInt(available), Freeze: decimal.NewFromInt(freeze)}
+	}
+
+	balanceRepo := &fakeSnapshotBalanceRepo{
+		balances: []*balance.Balance{
+			newBalance("0x01", 100, 10),
+			newBalance("0x02", 5, 0),
+			newBalance("0x03", 0, 0),
+			newBalance("0xpool", 1, 70),
+			newBalance(protocol.PlatformAddress, 1000, 0),
+		},
+	}
+	stakingRepo := &fakeSnapshotStakingRepo{
+		stakes: []*staking.StakedBalance{
+			{Staker: "0x02", Pool: "0xpool", Tick: "ethi", Amount: decimal.NewFromInt(50)},
+			{Staker: "0x03", Pool: "0xpool", Tick: "ethi", Amount: decimal.NewFromInt(20)},
+		},
+	}
+	srv := NewSnapshotService(&fakeSnapshotBlockRepo{last: 100}, balanceRepo, stakingRepo)
+
+	snapshot, err := srv.TakeSnapshot(context.Background(), SnapshotOptions{
+		Tick:     "ethi",
+		MinTotal: decimal.NewFromInt(10),
+		Exclude:  map[string]struct{}{protocol.PlatformAddress: {}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), snapshot.BlockNumber)
+
+	// the pool keeps its own available only, which is below the threshold.
+	var holders []string
+	for _, holder := range snapshot.Holders {
+		holders = append(holders, holder.Address)
+	}
+	assert.Equal(t, []string{"0x01", "0x02", "0x03"}, holders)
+	assert.Equal(t, "110", snapshot.Holders[0].Total().String())
+	assert.Equal(t, "50", snapshot.Holders[1].Staked.String())
+	assert.Equal(t, "20", snapshot.Holders[2].Total().String())
+
+	_, err = srv.TakeSnapshot(context.Background(), SnapshotOptions{Tick: "ethi", BlockNumber: 101})
+	assert.Error(t, err)
+
+	_, err = srv.TakeSnapshot(context.Background(), SnapshotOptions{Tick: "ethi", BlockNumber: 10})
+	assert.NoError(t, err)
+
+	// the pool froze less than its stakers staked.
+	stakingRepo.stakes = append(stakingRepo.stakes, &staking.StakedBalance{Staker: "0x04", Pool: "0xpool", Tick: "ethi", Amount: decimal.NewFromInt(1)})
+	_, err = srv.TakeSnapshot(context.Background(), SnapshotOptions{Tick: "ethi"})
+	assert.ErrorContains(t, err, "frozen balance of pool 0xpool is below its staked amount")
+}
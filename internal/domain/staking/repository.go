@@ -2,9 +2,50 @@ package staking
 
 import (
 	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
 )
 
+// ErrHistoryUnavailable is returned for a block before the staking history was recorded.
+var ErrHistoryUnavailable = errors.New("staking history is unavailable at the block")
+
+// StakedBalance is the amount of a tick staked by a staker in a pool.
+type StakedBalance struct {
+	Staker    string
+	Pool      string
+	PoolSubID uint64
+	Tick      string
+	Amount    decimal.Decimal
+}
+
+// PoolQueryOptions filters and pages a pool query. Cursor is the next cursor of the previous page, empty for the first page.
+type PoolQueryOptions struct {
+	Owner  string
+	Cursor string
+	Size   int
+}
+
+// PositionQueryOptions filters and pages a position query, by staker, by pool address or both.
+type PositionQueryOptions struct {
+	Staker string
+	Pool   string
+	Cursor string
+	Size   int
+}
+
 type StakingRepository interface {
 	LoadAllPools(ctx context.Context) (map[string]*PoolAggregate, error)
 	Save(ctx context.Context, blockNumber uint64, pool ...*PoolAggregate) error
+	Rollback(ctx context.Context, blockNumber uint64) error
+
+	// QueryPools returns a page of pools with the cursor of the next page, empty on the last page.
+	QueryPools(ctx context.Context, opts PoolQueryOptions) ([]*StakingPool, string, error)
+	// QueryPool returns nil if the pool does not exist.
+	QueryPool(ctx context.Context, pool string, poolSubID uint64) (*StakingPool, error)
+	// QueryPositions returns a page of positions with the cursor of the next page, empty on the last page.
+	QueryPositions(ctx context.Context, opts PositionQueryOptions) ([]*StakingPosition, string, error)
+	// QueryStakedBalancesAt returns the non-empty staked balances of the tick at the end of block blockNumber, it
+	// returns ErrHistoryUnavailable when the block is before the history of any of them was recorded.
+	QueryStakedBalancesAt(ctx context.Context, tick string, blockNumber uint64) ([]*StakedBalance, error)
 }
@@ -166,11 +166,16 @@ func (p *StakingPool) CalcAvailableRewards(blockNumber uint64, staker string) de
 		return decimal.Zero
 	}
 
+	return position.CalcAvailableRewards(p.RewardsBlock(blockNumber))
+}
+
+// RewardsBlock returns the block the rewards are calculated at, rewards of a time limited pool stop at its stop block.
+func (p *StakingPool) RewardsBlock(blockNumber uint64) uint64 {
 	if p.IsTimeLimited() {
-		blockNumber = min(blockNumber, p.Detail.StopBlock)
+		return min(blockNumber, p.Detail.StopBlock)
 	}
 
-	return position.CalcAvailableRewards(blockNumber)
+	return blockNumber
 }
 
 func (p *StakingPool) UpdatePool(command *protocol.ConfigStakeCommand) error {
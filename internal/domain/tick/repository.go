@@ -2,9 +2,25 @@ package tick
 
 import (
 	"context"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
 )
 
+// QueryOptions filters and pages a tick query. Cursor is the next cursor of the previous page, empty for the first page.
+type QueryOptions struct {
+	Protocol protocol.Protocol
+	Prefix   string
+	Cursor   string
+	Size     int
+}
+
 type TickRepository interface {
 	Load(ctx context.Context, name string) (Tick, error)
+	// LoadMany returns the existing ticks by name.
+	LoadMany(ctx context.Context, names []string) (map[string]Tick, error)
 	Save(ctx context.Context, entities ...Tick) error
+	Rollback(ctx context.Context, blockNumber uint64) error
+
+	// QueryTicks returns a page of ticks ordered by name with the cursor of the next page, empty on the last page.
+	QueryTicks(ctx context.Context, opts QueryOptions) ([]Tick, string, error)
 }
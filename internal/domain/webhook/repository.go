@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+)
+
+type Repository interface {
+	CreateEndpoint(ctx context.Context, endpoint *Endpoint) error
+	DeleteEndpoint(ctx context.Context, id int64) (bool, error)
+	ListEndpoints(ctx context.Context) ([]*Endpoint, error)
+
+	// GetCursor returns the position of the last block dispatched to the outbox, nil if none.
+	GetCursor(ctx context.Context) (*domain.EventCursor, error)
+	// Enqueue saves the deliveries of the blocks up to cursor and the cursor atomically.
+	Enqueue(ctx context.Context, cursor domain.EventCursor, deliveries []*Delivery) error
+	// LoadDueDeliveries returns pending deliveries due at now, ordered by id.
+	LoadDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*Delivery, error)
+	UpdateDelivery(ctx context.Context, delivery *Delivery) error
+	// PurgeDeliveries deletes the finished deliveries updated before.
+	PurgeDeliveries(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+)
+
+const (
+	MaxAttempts = 10
+
+	minBackoff = 5 * time.Second
+	maxBackoff = time.Hour
+)
+
+// Endpoint is a registered webhook receiving the events matched by its filters.
+type Endpoint struct {
+	ID            int64
+	URL           string
+	Secret        string
+	Ticks         []string
+	Addresses     []string
+	Operates      []protocol.Operate
+	IncludeFailed bool
+	CreatedAt     time.Time
+}
+
+func (e *Endpoint) Filter() *domain.EventFilter {
+	return domain.NewEventFilter(e.Ticks, e.Addresses, e.Operates, !e.IncludeFailed)
+}
+
+// NewSecret returns a random hmac secret.
+func NewSecret() string {
+	var buf [32]byte
+	_, _ = rand.Read(buf[:])
+	return hex.EncodeToString(buf[:])
+}
+
+// Sign returns the signature of a delivery, hex(hmac_sha256(secret, timestamp + "." + body)).
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsPublicIP reports whether ip may receive deliveries. loopback, private, link local and
+// unspecified addresses are refused, an endpoint must not reach the network of the indexer.
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast())
+}
+
+type DeliveryStatus uint8
+
+const (
+	DeliveryPending DeliveryStatus = iota
+	DeliveryDelivered
+	DeliveryFailed
+)
+
+// Delivery is a payload to post to an endpoint, kept in the outbox until it is delivered or given up.
+type Delivery struct {
+	ID            int64
+	EndpointID    int64
+	BlockNumber   uint64
+	Payload       []byte
+	Status        DeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+func NewDelivery(endpointID int64, blockNumber uint64, payload []byte, now time.Time) *Delivery {
+	return &Delivery{
+		EndpointID:    endpointID,
+		BlockNumber:   blockNumber,
+		Payload:       payload,
+		Status:        DeliveryPending,
+		NextAttemptAt: now,
+	}
+}
+
+func (d *Delivery) Delivered() {
+	d.Attempts++
+	d.Status = DeliveryDelivered
+	d.LastError = ""
+}
+
+// Fail gives up the delivery.
+func (d *Delivery) Fail(reason string) {
+	d.Status = DeliveryFailed
+	d.LastError = reason
+}
+
+// Retry schedules the next attempt with exponential backoff, the delivery fails after MaxAttempts.
+func (d *Delivery) Retry(err error, now time.Time) {
+	d.Attempts++
+	d.LastError = err.Error()
+	if len(d.LastError) > 255 {
+		d.LastError = d.LastError[:255]
+	}
+
+	if d.Attempts >= MaxAttempts {
+		d.Status = DeliveryFailed
+		return
+	}
+
+	backoff := minBackoff << (d.Attempts - 1)
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	d.NextAttemptAt = now.Add(backoff)
+}
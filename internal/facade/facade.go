@@ -6,11 +6,13 @@ import (
 	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
 	"github.com/IErcOrg/IERC_Indexer/internal/conf"
 	"github.com/IErcOrg/IERC_Indexer/internal/facade/handler"
-	"github.com/IErcOrg/IERC_Indexer/pkg/middleware"
+	pkgmiddleware "github.com/IErcOrg/IERC_Indexer/pkg/middleware"
 	"github.com/IErcOrg/IERC_Indexer/pkg/middleware/logging"
 	"github.com/go-kratos/kratos/v2/encoding/json"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -25,8 +27,20 @@ var ProviderSet = wire.NewSet(
 	handler.NewIndexHandler,
 	NewGRPCServer,
 	NewHTTPServer,
+	NewWebhookDispatcher,
 )
 
+// adminAuth guards the rpcs changing the indexer, the rest of the api only reads.
+func adminAuth(config *conf.Config) middleware.Middleware {
+	return selector.Server(pkgmiddleware.AdminAuth(config.Server.GetAdminToken())).
+		Path(
+			pb.OperationIndexerCreateWebhook,
+			pb.OperationIndexerListWebhooks,
+			pb.OperationIndexerDeleteWebhook,
+		).
+		Build()
+}
+
 // NewGRPCServer new a gRPC server.
 func NewGRPCServer(conf *conf.Config, h *handler.IndexHandler, logger log.Logger) *grpc.Server {
 	c := conf.Bootstrap.Server
@@ -35,6 +49,7 @@ func NewGRPCServer(conf *conf.Config, h *handler.IndexHandler, logger log.Logger
 		grpc.Middleware(
 			recovery.Recovery(),
 			logging.Server(logger),
+			adminAuth(conf),
 			validate.Validator(),
 		),
 		grpc.Options(
@@ -79,11 +94,13 @@ func NewHTTPServer(config *conf.Config, h *handler.IndexHandler, logger log.Logg
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
-			middleware.Cors(),
+			pkgmiddleware.Cors(),
 			logging.Server(logger),
+			adminAuth(config),
 			validate.Validator(),
 		),
 		http.Timeout(time.Second * 30),
+		http.Filter(newStreamGateway(h, logger).Filter),
 	}
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
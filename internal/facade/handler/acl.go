@@ -1,11 +1,41 @@
 package handler
 
 import (
+	"sort"
+	"time"
+
 	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/market"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/tick"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/webhook"
 )
 
+func ConvertEventsByBlockToSubscribeReply(data *domain.EventsByBlock) *pb.SubscribeReply {
+	if data.Rollback {
+		return &pb.SubscribeReply{BlockNumber: data.BlockNumber, Rollback: true, Cursor: data.Cursor().String()}
+	}
+
+	var reply = &pb.SubscribeReply{
+		BlockNumber:     data.BlockNumber,
+		PrevBlockNumber: data.PreviousBlock(),
+		Events:          make([]*pb.Event, 0, len(data.Events)),
+		Cursor:          data.Cursor().String(),
+	}
+
+	for _, item := range data.Events {
+		event := ConvertEventEntityToProtobuf(item)
+		if event != nil {
+			reply.Events = append(reply.Events, event)
+		}
+	}
+
+	return reply
+}
+
 func ConvertEventEntityToProtobuf(item domain.Event) *pb.Event {
 
 	switch ee := item.(type) {
@@ -47,6 +77,14 @@ var operateMap = map[protocol.Operate]pb.Operate{
 	protocol.OpPoWClaimAirdrop: pb.Operate_ClaimAirdrop,
 }
 
+var protocolOperateMap = func() map[pb.Operate]protocol.Operate {
+	var result = make(map[pb.Operate]protocol.Operate, len(operateMap))
+	for operate, value := range operateMap {
+		result[value] = operate
+	}
+	return result
+}()
+
 func convertOperate(operate protocol.Operate) pb.Operate {
 	op, existed := operateMap[operate]
 	if !existed {
@@ -251,3 +289,366 @@ func convertTickConfigDetails(details []*protocol.TickConfigDetail) []*pb.Stakin
 	return result
 
 }
+
+func ConvertBalanceEntityToProtobuf(entity *balance.Balance) *pb.Balance {
+	return &pb.Balance{
+		Address:          entity.Address,
+		Tick:             entity.Tick,
+		Available:        entity.Available.String(),
+		Freeze:           entity.Freeze.String(),
+		Minted:           entity.MintedAmount.String(),
+		Total:            entity.Total().String(),
+		LastUpdatedBlock: entity.LastUpdatedBlock,
+	}
+}
+
+func ConvertBalanceChangeEntityToProtobuf(change *balance.Change) *pb.BalanceChange {
+	return &pb.BalanceChange{
+		Address:        change.Address,
+		Tick:           change.Tick,
+		BlockNumber:    change.BlockNumber,
+		Available:      change.Available.String(),
+		Freeze:         change.Freeze.String(),
+		Minted:         change.MintedAmount.String(),
+		AvailableDelta: change.AvailableDelta.String(),
+		FreezeDelta:    change.FreezeDelta.String(),
+		MintedDelta:    change.MintedDelta.String(),
+	}
+}
+
+func ConvertListingEntityToProtobuf(listing *market.Listing) *pb.Listing {
+	return &pb.Listing{
+		Status:       listing.Status.String(),
+		Sign:         listing.Sign,
+		Protocol:     string(listing.Protocol),
+		Tick:         listing.Tick,
+		Seller:       listing.Seller,
+		Platform:     listing.Platform,
+		Amount:       listing.Amount.String(),
+		Value:        listing.Value.String(),
+		Price:        listing.Price.String(),
+		ListedTxHash: listing.TxHash,
+		ListedBlock:  listing.ListedBlock,
+		Buyer:        listing.Buyer,
+		ClosedTxHash: listing.ClosedTxHash,
+		ClosedBlock:  listing.ClosedBlock,
+	}
+}
+
+func ConvertTickStatsEntityToProtobuf(stats *market.TickStats) *pb.TickStats {
+	return &pb.TickStats{
+		Tick:           stats.Tick,
+		BlockNumber:    stats.BlockNumber,
+		Day:            stats.Day.Format(time.DateOnly),
+		HolderDelta:    stats.HolderDelta,
+		Transfers:      stats.Transfers,
+		Mints:          stats.Mints,
+		Minted:         stats.Minted.String(),
+		Trades:         stats.Trades,
+		Volume:         stats.Volume.String(),
+		Holders:        stats.Holders,
+		TotalTransfers: stats.TotalTransfers,
+		TotalMints:     stats.TotalMints,
+		TotalMinted:    stats.TotalMinted.String(),
+		TotalTrades:    stats.TotalTrades,
+		TotalVolume:    stats.TotalVolume.String(),
+		LastPrice:      stats.LastPrice.String(),
+	}
+}
+
+// ConvertTickEntityToProtobuf converts a tick, the supply of a pow tick is projected to the given block.
+func ConvertTickEntityToProtobuf(entity tick.Tick, projectedBlock uint64) *pb.Tick {
+	switch t := entity.(type) {
+	case *tick.IERC20Tick:
+		return &pb.Tick{
+			Protocol:         string(t.Protocol),
+			Tick:             t.Tick,
+			Decimals:         t.Decimals,
+			MaxSupply:        t.MaxSupply.String(),
+			Supply:           t.Supply.String(),
+			Creator:          t.Creator,
+			LastUpdatedBlock: t.LastUpdatedAtBlock,
+			Detail: &pb.Tick_Ierc20{Ierc20: &pb.Tick_IERC20Detail{
+				Limit:       t.Limit.String(),
+				WalletLimit: t.WalletLimit.String(),
+				Workc:       t.WorkC,
+			}},
+		}
+
+	case *tick.IERCPoWTick:
+		supply := t.Supply()
+		projectedBlock = max(projectedBlock, t.LastUpdatedBlock())
+		return &pb.Tick{
+			Protocol:         string(t.Protocol),
+			Tick:             t.Tick,
+			Decimals:         t.Decimals,
+			MaxSupply:        t.MaxSupply.String(),
+			Supply:           supply.String(),
+			Creator:          t.Creator,
+			LastUpdatedBlock: t.LastUpdatedBlock(),
+			Detail: &pb.Tick_IercPow{IercPow: &pb.Tick_IERCPoWDetail{
+				TokenomicsDetails: convertTokenomicsDetails(t.Tokenomics),
+				Rule:              convertDistributionRuleToPB(&t.Rule),
+				PowSupply:         t.PoWSupply.String(),
+				PosSupply:         t.PoSSupply.String(),
+				AirdropAmount:     t.AirdropAmount.String(),
+				PowRemainSupply:   t.PoWRemainSupply().String(),
+				PosRemainSupply:   t.PoSRemainSupply().String(),
+				RemainSupply:      t.MaxSupply.Sub(supply).String(),
+				ProjectedSupply:   t.CalcSupplyByBlockNumber(projectedBlock).String(),
+				ProjectedBlock:    projectedBlock,
+			}},
+		}
+
+	default:
+		panic("invalid tick type")
+	}
+}
+
+func ConvertStakingPoolEntityToProtobuf(pool *staking.StakingPool) *pb.StakingPool {
+	var details = make([]*pb.StakingPool_TickDetail, 0, len(pool.Detail.TickDetails))
+	for _, detail := range pool.Detail.TickDetails {
+		details = append(details, &pb.StakingPool_TickDetail{
+			Tick:          detail.Tick,
+			Ratio:         detail.Ratio.String(),
+			Amount:        detail.Amount.String(),
+			MaxAmount:     detail.MaxAmount.String(),
+			HistoryAmount: detail.HistoryAmount.String(),
+		})
+	}
+
+	sort.Slice(details, func(i, j int) bool {
+		return pool.Detail.TickDetails[details[i].Tick].Index < pool.Detail.TickDetails[details[j].Tick].Index
+	})
+
+	return &pb.StakingPool{
+		Pool:             pool.Pool,
+		PoolSubId:        pool.PoolSubID,
+		Name:             pool.Detail.Name,
+		Owner:            pool.Detail.Owner,
+		Admins:           pool.Detail.Admins,
+		StartBlock:       pool.Detail.StartBlock,
+		StopBlock:        pool.Detail.StopBlock,
+		TickDetails:      details,
+		LastUpdatedBlock: pool.LastUpdatedBlock,
+	}
+}
+
+// ConvertStakingPositionEntityToProtobuf converts a position with its rewards available at the given block.
+func ConvertStakingPositionEntityToProtobuf(position *staking.StakingPosition, pool *staking.StakingPool, blockNumber uint64) *pb.StakingPosition {
+	var details = make([]*pb.StakingPosition_TickDetail, 0, len(position.TickDetails))
+	for _, detail := range position.TickDetails {
+		details = append(details, &pb.StakingPosition_TickDetail{
+			Tick:   detail.Tick,
+			Ratio:  detail.Ratio.String(),
+			Amount: detail.Amount.String(),
+		})
+	}
+
+	sort.Slice(details, func(i, j int) bool { return details[i].Tick < details[j].Tick })
+
+	if pool != nil {
+		blockNumber = pool.RewardsBlock(blockNumber)
+	}
+
+	return &pb.StakingPosition{
+		Pool:             position.PoolAddress,
+		PoolSubId:        position.PoolSubID,
+		Staker:           position.Staker,
+		TickDetails:      details,
+		RewardsPerBlock:  position.RewardsPerBlock.String(),
+		AccRewards:       position.AccReward.String(),
+		Debt:             position.Debt.String(),
+		AvailableRewards: position.CalcAvailableRewards(blockNumber).String(),
+		RewardsBlock:     blockNumber,
+		LastRewardBlock:  position.LastRewardBlock,
+		LastUpdatedBlock: position.LastUpdatedBlock,
+	}
+}
+
+func ConvertWebhookEntityToProtobuf(endpoint *webhook.Endpoint) *pb.Webhook {
+	var operates = make([]pb.Operate, 0, len(endpoint.Operates))
+	for _, operate := range endpoint.Operates {
+		operates = append(operates, operateMap[operate])
+	}
+
+	return &pb.Webhook{
+		Id:            endpoint.ID,
+		Url:           endpoint.URL,
+		Ticks:         endpoint.Ticks,
+		Addresses:     endpoint.Addresses,
+		Operates:      operates,
+		IncludeFailed: endpoint.IncludeFailed,
+		CreatedAt:     endpoint.CreatedAt.UnixMilli(),
+	}
+}
+
+func ConvertTransactionEntityToProtobuf(tx *domain.Transaction) *pb.Transaction {
+	result := &pb.Transaction{
+		BlockNumber: tx.BlockNumber,
+		Position:    tx.PositionInTxs,
+		Hash:        tx.Hash,
+		From:        tx.From,
+		To:          tx.To,
+		Value:       tx.TxValue.String(),
+		Gas:         tx.Gas.String(),
+		GasPrice:    tx.GasPrice.String(),
+		Nonce:       tx.Nonce,
+		Data:        tx.TxData,
+		IsProcessed: tx.IsProcessed,
+		Code:        tx.Code,
+		Remark:      tx.Remark,
+	}
+
+	if tx.IERCTransaction != nil {
+		result.IercTransaction = convertIERCTransactionToPB(tx.IERCTransaction)
+	}
+
+	return result
+}
+
+func convertIERCTransactionToPB(tx protocol.IERCTransaction) *pb.IERCTransaction {
+	var (
+		result = &pb.IERCTransaction{}
+		base   *protocol.IERCTransactionBase
+	)
+
+	switch cmd := tx.(type) {
+	case *protocol.DeployCommand:
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_Deploy_{Deploy: &pb.IERCTransaction_Deploy{
+			Tick:        cmd.Tick,
+			Decimals:    cmd.Decimals,
+			MaxSupply:   cmd.MaxSupply.String(),
+			Limit:       cmd.MintLimitOfSingleTx.String(),
+			WalletLimit: cmd.MintLimitOfWallet.String(),
+			Workc:       cmd.Workc,
+			Nonce:       cmd.Nonce,
+		}}
+
+	case *protocol.MintCommand:
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_Mint_{Mint: &pb.IERCTransaction_Mint{
+			Tick:   cmd.Tick,
+			Amount: cmd.Amount.String(),
+			Nonce:  cmd.Nonce,
+		}}
+
+	case *protocol.DeployPoWCommand:
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_DeployPow{DeployPow: &pb.IERCTransaction_DeployPoW{
+			Tick:              cmd.Tick,
+			Decimals:          cmd.Decimals,
+			MaxSupply:         cmd.MaxSupply.String(),
+			TokenomicsDetails: convertTokenomicsDetails(cmd.TokenomicsDetails),
+			Rule:              convertDistributionRuleToPB(&cmd.DistributionRule),
+		}}
+
+	case *protocol.MintPoWCommand:
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_MintPow{MintPow: &pb.IERCTransaction_MintPoW{
+			Tick:   cmd.Tick(),
+			Points: cmd.Points().String(),
+			Block:  cmd.Block(),
+			Nonce:  cmd.Nonce(),
+		}}
+
+	case *protocol.TransferCommand:
+		var records = make([]*pb.IERCTransaction_TransferRecord, 0, len(cmd.Records))
+		for _, record := range cmd.Records {
+			records = append(records, &pb.IERCTransaction_TransferRecord{
+				Tick:   record.Tick,
+				From:   record.From,
+				To:     record.Recv,
+				Amount: record.Amount.String(),
+			})
+		}
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_Transfer_{Transfer: &pb.IERCTransaction_Transfer{Records: records}}
+
+	case *protocol.FreezeSellCommand:
+		var records = make([]*pb.IERCTransaction_FreezeRecord, 0, len(cmd.Records))
+		for _, record := range cmd.Records {
+			records = append(records, &pb.IERCTransaction_FreezeRecord{
+				Tick:       record.Tick,
+				Platform:   record.Platform,
+				Seller:     record.Seller,
+				SellerSign: record.SellerSign,
+				SignNonce:  record.SignNonce,
+				Amount:     record.Amount.String(),
+				Value:      record.Value.String(),
+				GasPrice:   record.GasPrice.String(),
+			})
+		}
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_FreezeSell_{FreezeSell: &pb.IERCTransaction_FreezeSell{Records: records}}
+
+	case *protocol.UnfreezeSellCommand:
+		var records = make([]*pb.IERCTransaction_UnfreezeRecord, 0, len(cmd.Records))
+		for _, record := range cmd.Records {
+			records = append(records, &pb.IERCTransaction_UnfreezeRecord{
+				TxHash:   record.TxHash,
+				Position: record.PositionInIERC20Txs,
+				Sign:     record.Sign,
+				Msg:      record.Msg,
+			})
+		}
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_UnfreezeSell_{UnfreezeSell: &pb.IERCTransaction_UnfreezeSell{Records: records}}
+
+	case *protocol.ProxyTransferCommand:
+		var records = make([]*pb.IERCTransaction_ProxyTransferRecord, 0, len(cmd.Records))
+		for _, record := range cmd.Records {
+			records = append(records, &pb.IERCTransaction_ProxyTransferRecord{
+				Tick:        record.Tick,
+				From:        record.From,
+				To:          record.To,
+				Amount:      record.Amount.String(),
+				Value:       record.Value.String(),
+				Sign:        record.Sign,
+				SignerNonce: record.SignerNonce,
+			})
+		}
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_ProxyTransfer_{ProxyTransfer: &pb.IERCTransaction_ProxyTransfer{Records: records}}
+
+	case *protocol.ConfigStakeCommand:
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_ConfigStake_{ConfigStake: &pb.IERCTransaction_ConfigStake{
+			Pool:      cmd.Pool,
+			PoolSubId: cmd.PoolSubID,
+			Owner:     cmd.Owner,
+			Admins:    cmd.Admins,
+			Name:      cmd.Name,
+			StopBlock: cmd.StopBlock,
+			Details:   convertTickConfigDetails(cmd.Details),
+		}}
+
+	case *protocol.StakingCommand:
+		var records = make([]*pb.IERCTransaction_StakingRecord, 0, len(cmd.Details))
+		for _, detail := range cmd.Details {
+			records = append(records, &pb.IERCTransaction_StakingRecord{
+				Staker:    detail.Staker,
+				Pool:      detail.Pool,
+				PoolSubId: detail.PoolSubID,
+				Tick:      detail.Tick,
+				Amount:    detail.Amount.String(),
+			})
+		}
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_Staking_{Staking: &pb.IERCTransaction_Staking{
+			Pool:      cmd.Pool,
+			PoolSubId: cmd.PoolSubID,
+			Records:   records,
+		}}
+
+	case *protocol.ModifyCommand:
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_Modify_{Modify: &pb.IERCTransaction_Modify{
+			Tick:      cmd.Tick,
+			MaxSupply: cmd.MaxSupply.String(),
+		}}
+
+	case *protocol.ClaimAirdropCommand:
+		base, result.Command = &cmd.IERCTransactionBase, &pb.IERCTransaction_ClaimAirdrop_{ClaimAirdrop: &pb.IERCTransaction_ClaimAirdrop{
+			Tick:   cmd.Tick,
+			Amount: cmd.ClaimAmount.String(),
+		}}
+
+	default:
+		return nil
+	}
+
+	result.Protocol = string(base.Protocol)
+	result.Operate = convertOperate(base.Operate)
+	return result
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *IndexHandler) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.GetBalanceReply, error) {
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	if req.Tick == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tick")
+	}
+
+	entity, err := s.balanceRepo.Load(ctx, balance.NewBalanceKey(strings.ToLower(req.Address), req.Tick))
+	if err != nil {
+		return nil, err
+	}
+
+	if entity == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &pb.GetBalanceReply{Balance: ConvertBalanceEntityToProtobuf(entity)}, nil
+}
+
+func (s *IndexHandler) ListBalancesByAddress(ctx context.Context, req *pb.ListBalancesByAddressRequest) (*pb.ListBalancesByAddressReply, error) {
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	entities, next, err := s.balanceRepo.QueryBalancesByAddress(ctx, strings.ToLower(req.Address), balance.QueryOptions{
+		Cursor:       req.Cursor,
+		Size:         pageSize(req.Size),
+		OrderByTotal: req.OrderByTotal,
+	})
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	var balances = make([]*pb.Balance, 0, len(entities))
+	for _, entity := range entities {
+		balances = append(balances, ConvertBalanceEntityToProtobuf(entity))
+	}
+
+	return &pb.ListBalancesByAddressReply{Balances: balances, NextCursor: next}, nil
+}
+
+func (s *IndexHandler) ListHoldersByTick(ctx context.Context, req *pb.ListHoldersByTickRequest) (*pb.ListHoldersByTickReply, error) {
+
+	if req.Tick == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tick")
+	}
+
+	entities, next, err := s.balanceRepo.QueryHoldersByTick(ctx, req.Tick, balance.QueryOptions{
+		Cursor: req.Cursor,
+		Size:   pageSize(req.Size),
+	})
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	var holders = make([]*pb.Balance, 0, len(entities))
+	for _, entity := range entities {
+		holders = append(holders, ConvertBalanceEntityToProtobuf(entity))
+	}
+
+	return &pb.ListHoldersByTickReply{Holders: holders, NextCursor: next}, nil
+}
+
+func (s *IndexHandler) GetBalanceAt(ctx context.Context, req *pb.GetBalanceAtRequest) (*pb.GetBalanceAtReply, error) {
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	if req.Tick == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tick")
+	}
+
+	// the balances above the last handled block are not known yet.
+	last, err := s.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if last == nil || req.BlockNumber > last.Number {
+		var lastNumber uint64
+		if last != nil {
+			lastNumber = last.Number
+		}
+		return nil, status.Errorf(codes.OutOfRange, "block %d is not handled yet, last handled block: %d", req.BlockNumber, lastNumber)
+	}
+
+	entity, err := s.balanceRepo.LoadAt(ctx, balance.NewBalanceKey(strings.ToLower(req.Address), req.Tick), req.BlockNumber)
+	if err != nil {
+		if errors.Is(err, balance.ErrHistoryUnavailable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	if entity == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &pb.GetBalanceAtReply{Balance: ConvertBalanceEntityToProtobuf(entity)}, nil
+}
+
+func (s *IndexHandler) ListBalanceChanges(ctx context.Context, req *pb.ListBalanceChangesRequest) (*pb.ListBalanceChangesReply, error) {
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	entities, next, err := s.balanceRepo.QueryChanges(ctx, strings.ToLower(req.Address), balance.ChangeQueryOptions{
+		Tick:   req.Tick,
+		Cursor: req.Cursor,
+		Size:   pageSize(req.Size),
+	})
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	var changes = make([]*pb.BalanceChange, 0, len(entities))
+	for _, entity := range entities {
+		changes = append(changes, ConvertBalanceChangeEntityToProtobuf(entity))
+	}
+
+	return &pb.ListBalanceChangesReply{Changes: changes, NextCursor: next}, nil
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeBalanceRepo struct {
+	balance.BalanceRepository
+	address string
+	opts    balance.QueryOptions
+}
+
+func (f *fakeBalanceRepo) QueryBalancesByAddress(_ context.Context, address string, opts balance.QueryOptions) ([]*balance.Balance, string, error) {
+	f.address, f.opts = address, opts
+	if opts.Cursor != "" {
+		return nil, "", utils.ErrInvalidCursor
+	}
+
+	entity := &balance.Balance{Address: address, Tick: "ethi", Available: decimal.NewFromInt(2), Freeze: decimal.NewFromInt(1), MintedAmount: decimal.Zero}
+	return []*balance.Balance{entity}, utils.EncodeCursor("ethi"), nil
+}
+
+func TestListBalancesByAddress(t *testing.T) {
+	repo := &fakeBalanceRepo{}
+	h := &IndexHandler{balanceRepo: repo}
+
+	reply, err := h.ListBalancesByAddress(context.Background(), &pb.ListBalancesByAddressRequest{Address: "0xABC", Size: 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, "0xabc", repo.address)
+	assert.Equal(t, maxPageSize, repo.opts.Size)
+	assert.Equal(t, "3", reply.Balances[0].Total)
+	assert.NotEmpty(t, reply.NextCursor)
+
+	_, err = h.ListBalancesByAddress(context.Background(), &pb.ListBalancesByAddressRequest{Address: "0xabc", Cursor: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = h.ListBalancesByAddress(context.Background(), &pb.ListBalancesByAddressRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCursor(t *testing.T) {
+	values, err := utils.DecodeCursor(utils.EncodeCursor("1.5", "7"), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.5", "7"}, values)
+
+	_, err = utils.DecodeCursor(utils.EncodeCursor("1.5"), 2)
+	assert.ErrorIs(t, err, utils.ErrInvalidCursor)
+}
+
+func (f *fakeBalanceRepo) LoadAt(_ context.Context, key balance.BalanceKey, blockNumber uint64) (*balance.Balance, error) {
+	switch {
+	case blockNumber < 10:
+		return nil, balance.ErrHistoryUnavailable
+	case key.Tick != "ethi":
+		return nil, nil
+	default:
+		return &balance.Balance{Address: key.Address, Tick: key.Tick, Available: decimal.NewFromInt(5), LastUpdatedBlock: 10}, nil
+	}
+}
+
+type fakeLastBlockRepo struct {
+	domain.BlockRepository
+	last *domain.BlockHeader
+}
+
+func (f *fakeLastBlockRepo) GetLastHandleBlock(context.Context) (*domain.BlockHeader, error) {
+	return f.last, nil
+}
+
+func TestGetBalanceAt(t *testing.T) {
+	blockRepo := &fakeLastBlockRepo{last: &domain.BlockHeader{Number: 20}}
+	h := &IndexHandler{balanceRepo: &fakeBalanceRepo{}, blockRepo: blockRepo}
+
+	reply, err := h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{Address: "0xABC", Tick: "ethi", BlockNumber: 12})
+	assert.NoError(t, err)
+	assert.Equal(t, "0xabc", reply.Balance.Address)
+	assert.Equal(t, "5", reply.Balance.Total)
+	assert.Equal(t, uint64(10), reply.Balance.LastUpdatedBlock)
+
+	_, err = h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{Address: "0xabc", Tick: "other", BlockNumber: 12})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{Address: "0xabc", Tick: "ethi", BlockNumber: 9})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	reply, err = h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{Address: "0xabc", Tick: "ethi", BlockNumber: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, "5", reply.Balance.Total)
+
+	// the block is above the last handled block.
+	_, err = h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{Address: "0xabc", Tick: "ethi", BlockNumber: 21})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+
+	blockRepo.last = nil
+	_, err = h.GetBalanceAt(context.Background(), &pb.GetBalanceAtRequest{Address: "0xabc", Tick: "ethi", BlockNumber: 1})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
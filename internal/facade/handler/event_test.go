@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeEventRepo struct {
+	domain.EventRepository
+	query    *domain.EventQuery
+	blockNum int
+}
+
+func (f *fakeEventRepo) QueryEvents(_ context.Context, query domain.EventQuery) ([]*domain.EventsByBlock, string, error) {
+	f.query = &query
+	return nil, "next", nil
+}
+
+func (f *fakeEventRepo) QueryEventsByBlocks(_ context.Context, _ uint64, blockNum int) ([]*domain.EventsByBlock, error) {
+	f.blockNum = blockNum
+	return nil, nil
+}
+
+func TestQueryEventsWithFilters(t *testing.T) {
+	repo := &fakeEventRepo{}
+	h := &IndexHandler{aggRepo: repo}
+
+	_, err := h.QueryEvents(context.Background(), &pb.QueryEventsRequest{StartBlock: 10, Size: 2})
+	assert.NoError(t, err)
+	assert.Nil(t, repo.query)
+	assert.Equal(t, 2, repo.blockNum)
+
+	_, err = h.QueryEvents(context.Background(), &pb.QueryEventsRequest{StartBlock: 10, Size: 10000})
+	assert.NoError(t, err)
+	assert.Nil(t, repo.query)
+	assert.Equal(t, 10000, repo.blockNum)
+
+	errCode := int32(0)
+	reply, err := h.QueryEvents(context.Background(), &pb.QueryEventsRequest{
+		Tick:        "ethi",
+		Address:     "0xABC",
+		Operate:     pb.Operate_Transfer,
+		ErrCode:     &errCode,
+		EventAtFrom: 1700000000000,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "next", reply.NextCursor)
+	assert.Equal(t, "0xabc", repo.query.Address)
+	assert.Equal(t, protocol.Operate(protocol.OpTransfer), repo.query.Operate)
+	assert.Equal(t, int64(1700000000000), repo.query.EventAtFrom.UnixMilli())
+	assert.True(t, repo.query.EventAtTo.IsZero())
+	assert.Equal(t, defaultPageSize, repo.query.Size)
+
+	// the page size alone pages the events.
+	_, err = h.QueryEvents(context.Background(), &pb.QueryEventsRequest{StartBlock: 10, Size: 5, PageSize: 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), repo.query.StartBlock)
+	assert.Equal(t, maxPageSize, repo.query.Size)
+}
+
+func TestSubscribeEventFilter(t *testing.T) {
+	filter, err := convertEventFilter(&pb.SubscribeRequest{})
+	assert.NoError(t, err)
+	assert.Nil(t, filter)
+
+	includeFailed := false
+	filter, err = convertEventFilter(&pb.SubscribeRequest{
+		Ticks:         []string{"ethi"},
+		Addresses:     []string{"0xABC"},
+		Operates:      []pb.Operate{pb.Operate_Transfer},
+		IncludeFailed: &includeFailed,
+	})
+	assert.NoError(t, err)
+
+	newTransfer := func(tick, from string, errCode int32) domain.Event {
+		return &domain.IERC20TransferredEvent{
+			BlockNumber: 10,
+			Data:        &domain.IERC20Transferred{Operate: protocol.OpTransfer, Tick: tick, From: from, To: "0x01"},
+			ErrCode:     errCode,
+		}
+	}
+
+	matched := newTransfer("ethi", "0xabc", 0)
+	block := filter.Apply(&domain.EventsByBlock{BlockNumber: 10, Events: []domain.Event{
+		matched,
+		newTransfer("other", "0xabc", 0),
+		newTransfer("ethi", "0x02", 0),
+		newTransfer("ethi", "0xabc", 1),
+	}})
+	assert.Equal(t, []domain.Event{matched}, block.Events)
+
+	assert.Nil(t, filter.Apply(&domain.EventsByBlock{BlockNumber: 11, Events: []domain.Event{newTransfer("other", "0xabc", 0)}}))
+	assert.True(t, filter.Apply(&domain.EventsByBlock{BlockNumber: 9, Rollback: true}).Rollback)
+}
@@ -3,17 +3,49 @@ package handler
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/market"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/service"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/tick"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/webhook"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func pageSize(size int64) int {
+	switch {
+	case size <= 0:
+		return defaultPageSize
+	case size > maxPageSize:
+		return maxPageSize
+	default:
+		return int(size)
+	}
+}
+
+func convertQueryError(err error) error {
+	if errors.Is(err, utils.ErrInvalidCursor) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
 type IndexHandler struct {
 	pb.UnimplementedIndexerServer
 
@@ -25,14 +57,30 @@ type IndexHandler struct {
 	fetcher   domain.BlockFetcher
 	blockRepo domain.BlockRepository
 
+	balanceRepo balance.BalanceRepository
+	tickRepo    tick.TickRepository
+	stakingRepo staking.StakingRepository
+	webhookRepo webhook.Repository
+	listingRepo market.ListingRepository
+	statsRepo   market.StatsRepository
+
+	enableWebhook bool
+
 	logger *log.Helper
 }
 
 func NewIndexHandler(
+	config *conf.Config,
 	srv *service.IndexDomainService,
 	aggRepo domain.EventRepository,
 	fetcher domain.BlockFetcher,
 	blockRepo domain.BlockRepository,
+	balanceRepo balance.BalanceRepository,
+	tickRepo tick.TickRepository,
+	stakingRepo staking.StakingRepository,
+	webhookRepo webhook.Repository,
+	listingRepo market.ListingRepository,
+	statsRepo market.StatsRepository,
 	logger log.Logger,
 ) *IndexHandler {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -44,6 +92,13 @@ func NewIndexHandler(
 		aggRepo:                    aggRepo,
 		fetcher:                    fetcher,
 		blockRepo:                  blockRepo,
+		balanceRepo:                balanceRepo,
+		tickRepo:                   tickRepo,
+		stakingRepo:                stakingRepo,
+		webhookRepo:                webhookRepo,
+		listingRepo:                listingRepo,
+		statsRepo:                  statsRepo,
+		enableWebhook:              config.Runtime.GetEnableWebhook(),
 		logger:                     log.NewHelper(log.With(logger, "module", "handler")),
 	}
 }
@@ -62,8 +117,20 @@ func (s *IndexHandler) Stop(_ context.Context) error {
 
 func (s *IndexHandler) SubscribeEvent(req *pb.SubscribeRequest, conn pb.Indexer_SubscribeEventServer) error {
 
-	lastBlockNumber := req.StartBlock
-	stream, err := s.aggRepo.SubscribeEvent(conn.Context(), req.StartBlock)
+	filter, err := convertEventFilter(req)
+	if err != nil {
+		return err
+	}
+
+	after := domain.BlockCursor(req.StartBlock)
+	if req.Cursor != "" {
+		if after, err = domain.ParseEventCursor(req.Cursor); err != nil {
+			return convertQueryError(err)
+		}
+	}
+
+	lastBlockNumber := after.BlockNumber
+	stream, err := s.aggRepo.SubscribeEvent(conn.Context(), after, filter)
 	if err != nil {
 		return err
 	}
@@ -80,38 +147,55 @@ func (s *IndexHandler) SubscribeEvent(req *pb.SubscribeRequest, conn pb.Indexer_
 			return nil
 
 		case err := <-stream.Err():
+			if errors.Is(err, domain.ErrSlowSubscriber) {
+				return status.Error(codes.ResourceExhausted, err.Error())
+			}
 			return err
 
 		case data, ok := <-stream.Next():
 			if !ok {
 				return nil
 			}
-			if lastBlockNumber > data.BlockNumber {
-				continue
-			}
 
-			lastBlockNumber = data.BlockNumber
-
-			var reply = pb.SubscribeReply{
-				BlockNumber:     data.BlockNumber,
-				PrevBlockNumber: data.PreviousBlock(),
-				Events:          make([]*pb.Event, 0, len(data.Events)),
-			}
+			reply := ConvertEventsByBlockToSubscribeReply(data)
 
-			for _, item := range data.Events {
-				event := ConvertEventEntityToProtobuf(item)
-				if event != nil {
-					reply.Events = append(reply.Events, event)
-				}
+			// blocks without matched events are skipped, so the previous block is the last one sent.
+			if !data.Rollback && !filter.IsEmpty() {
+				reply.PrevBlockNumber = lastBlockNumber
 			}
 
-			if err := conn.Send(&reply); err != nil {
+			lastBlockNumber = data.BlockNumber
+			if err := conn.Send(reply); err != nil {
 				return err
 			}
 		}
 	}
 }
 
+func convertEventFilter(req *pb.SubscribeRequest) (*domain.EventFilter, error) {
+
+	var addresses = make([]string, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		addresses = append(addresses, strings.ToLower(address))
+	}
+
+	var operates = make([]protocol.Operate, 0, len(req.Operates))
+	for _, op := range req.Operates {
+		operate, ok := protocolOperateMap[op]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid operate")
+		}
+		operates = append(operates, operate)
+	}
+
+	filter := domain.NewEventFilter(req.Ticks, addresses, operates, req.IncludeFailed != nil && !*req.IncludeFailed)
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	return filter, nil
+}
+
 func (s *IndexHandler) SubscribeSystemStatus(_ *pb.SubscribeSystemStatusRequest, conn pb.Indexer_SubscribeSystemStatusServer) error {
 	var (
 		reply  pb.SubscribeSystemStatusReply
@@ -167,11 +251,60 @@ func (s *IndexHandler) SubscribeSystemStatus(_ *pb.SubscribeSystemStatusRequest,
 
 func (s *IndexHandler) QueryEvents(ctx context.Context, req *pb.QueryEventsRequest) (*pb.QueryEventsReply, error) {
 
+	if isFilteredEventsQuery(req) {
+		return s.queryFilteredEvents(ctx, req)
+	}
+
 	blocks, err := s.aggRepo.QueryEventsByBlocks(ctx, req.StartBlock, int(req.Size))
 	if err != nil {
 		return nil, err
 	}
 
+	return &pb.QueryEventsReply{EventByBlocks: convertEventsByBlocks(blocks)}, nil
+}
+
+func isFilteredEventsQuery(req *pb.QueryEventsRequest) bool {
+	return req.Tick != "" || req.Address != "" || req.Operate != pb.Operate_OPERATE_UNSPECIFIED || req.ErrCode != nil ||
+		req.EventAtFrom != 0 || req.EventAtTo != 0 || req.Cursor != "" || req.PageSize != 0
+}
+
+func (s *IndexHandler) queryFilteredEvents(ctx context.Context, req *pb.QueryEventsRequest) (*pb.QueryEventsReply, error) {
+
+	var query = domain.EventQuery{
+		StartBlock: req.StartBlock,
+		Tick:       req.Tick,
+		Address:    strings.ToLower(req.Address),
+		ErrCode:    req.ErrCode,
+		Cursor:     req.Cursor,
+		Size:       pageSize(req.PageSize),
+	}
+
+	if req.Operate != pb.Operate_OPERATE_UNSPECIFIED {
+		operate, ok := protocolOperateMap[req.Operate]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid operate")
+		}
+		query.Operate = operate
+	}
+
+	if req.EventAtFrom != 0 {
+		query.EventAtFrom = time.UnixMilli(req.EventAtFrom)
+	}
+
+	if req.EventAtTo != 0 {
+		query.EventAtTo = time.UnixMilli(req.EventAtTo)
+	}
+
+	blocks, next, err := s.aggRepo.QueryEvents(ctx, query)
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	return &pb.QueryEventsReply{EventByBlocks: convertEventsByBlocks(blocks), NextCursor: next}, nil
+}
+
+func convertEventsByBlocks(blocks []*domain.EventsByBlock) []*pb.QueryEventsReply_EventsByBlock {
+
 	var data = make([]*pb.QueryEventsReply_EventsByBlock, 0, len(blocks))
 	for _, block := range blocks {
 
@@ -190,23 +323,33 @@ func (s *IndexHandler) QueryEvents(ctx context.Context, req *pb.QueryEventsReque
 		})
 	}
 
-	return &pb.QueryEventsReply{EventByBlocks: data}, nil
+	return data
 }
 
 func (s *IndexHandler) QuerySystemStatus(ctx context.Context, req *pb.QuerySystemStatusRequest) (*pb.QuerySystemStatusReply, error) {
 
+	syncBlock, err := s.syncBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.QuerySystemStatusReply{SyncBlock: syncBlock}, nil
+}
+
+func (s *IndexHandler) syncBlock(ctx context.Context) (uint64, error) {
+
 	lastBlock, err := s.aggRepo.GetBlockNumberByLastEvent(ctx)
 
 	sync, err := s.blockRepo.QueryLastProcessedBlock(ctx, lastBlock)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if sync == nil {
-		return &pb.QuerySystemStatusReply{SyncBlock: lastBlock}, nil
+		return lastBlock, nil
 	}
 
-	return &pb.QuerySystemStatusReply{SyncBlock: sync.Number}, nil
+	return sync.Number, nil
 }
 
 func (s *IndexHandler) CheckTransfer(ctx context.Context, req *pb.CheckTransferRequest) (*pb.CheckTransferReply, error) {
@@ -259,7 +402,7 @@ func (s *IndexHandler) checkTransfer(ctx context.Context, req *pb.CheckTransferR
 		return nil, err
 	}
 
-	if !tx.IsProcessed {
+	if tx == nil || !tx.IsProcessed {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
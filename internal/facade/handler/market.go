@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/market"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var listingStatusMap = map[string]market.ListingStatus{
+	"":                               0,
+	market.ListingListed.String():    market.ListingListed,
+	market.ListingSold.String():      market.ListingSold,
+	market.ListingCancelled.String(): market.ListingCancelled,
+}
+
+func (s *IndexHandler) GetListing(ctx context.Context, req *pb.GetListingRequest) (*pb.GetListingReply, error) {
+
+	if req.Sign == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid sign")
+	}
+
+	listing, err := s.listingRepo.QueryListing(ctx, req.Sign)
+	if err != nil {
+		return nil, err
+	}
+
+	if listing == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &pb.GetListingReply{Listing: ConvertListingEntityToProtobuf(listing)}, nil
+}
+
+func (s *IndexHandler) ListListings(ctx context.Context, req *pb.ListListingsRequest) (*pb.ListListingsReply, error) {
+
+	listingStatus, ok := listingStatusMap[strings.ToLower(req.Status)]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	listings, next, err := s.listingRepo.QueryListings(ctx, market.ListingQueryOptions{
+		Tick:         req.Tick,
+		Seller:       strings.ToLower(req.Seller),
+		Status:       listingStatus,
+		OrderByPrice: req.OrderByPrice,
+		Cursor:       req.Cursor,
+		Size:         pageSize(req.Size),
+	})
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	var data = make([]*pb.Listing, 0, len(listings))
+	for _, listing := range listings {
+		data = append(data, ConvertListingEntityToProtobuf(listing))
+	}
+
+	return &pb.ListListingsReply{Listings: data, NextCursor: next}, nil
+}
+
+var statsPeriodMap = map[string]market.StatsPeriod{
+	"":      market.StatsPeriodDay,
+	"day":   market.StatsPeriodDay,
+	"block": market.StatsPeriodBlock,
+}
+
+func (s *IndexHandler) GetTickStats(ctx context.Context, req *pb.GetTickStatsRequest) (*pb.GetTickStatsReply, error) {
+
+	if req.Tick == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tick")
+	}
+
+	stats, err := s.statsRepo.QueryLatest(ctx, req.Tick)
+	if err != nil {
+		return nil, err
+	}
+
+	if stats == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &pb.GetTickStatsReply{Stats: ConvertTickStatsEntityToProtobuf(stats)}, nil
+}
+
+func (s *IndexHandler) ListTickStats(ctx context.Context, req *pb.ListTickStatsRequest) (*pb.ListTickStatsReply, error) {
+
+	if req.Tick == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tick")
+	}
+
+	period, ok := statsPeriodMap[strings.ToLower(req.Period)]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid period")
+	}
+
+	stats, next, err := s.statsRepo.QueryStats(ctx, market.StatsQueryOptions{
+		Tick:   req.Tick,
+		Period: period,
+		Cursor: req.Cursor,
+		Size:   pageSize(req.Size),
+	})
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	var data = make([]*pb.TickStats, 0, len(stats))
+	for _, entity := range stats {
+		data = append(data, ConvertTickStatsEntityToProtobuf(entity))
+	}
+
+	return &pb.ListTickStatsReply{Stats: data, NextCursor: next}, nil
+}
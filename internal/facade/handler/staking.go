@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *IndexHandler) ListStakingPools(ctx context.Context, req *pb.ListStakingPoolsRequest) (*pb.ListStakingPoolsReply, error) {
+
+	pools, next, err := s.stakingRepo.QueryPools(ctx, staking.PoolQueryOptions{
+		Owner:  strings.ToLower(req.Owner),
+		Cursor: req.Cursor,
+		Size:   pageSize(req.Size),
+	})
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	var data = make([]*pb.StakingPool, 0, len(pools))
+	for _, pool := range pools {
+		data = append(data, ConvertStakingPoolEntityToProtobuf(pool))
+	}
+
+	return &pb.ListStakingPoolsReply{Pools: data, NextCursor: next}, nil
+}
+
+func (s *IndexHandler) GetStakingPool(ctx context.Context, req *pb.GetStakingPoolRequest) (*pb.GetStakingPoolReply, error) {
+
+	if req.Pool == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid pool")
+	}
+
+	pool, err := s.stakingRepo.QueryPool(ctx, strings.ToLower(req.Pool), req.PoolSubId)
+	if err != nil {
+		return nil, err
+	}
+
+	if pool == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &pb.GetStakingPoolReply{Pool: ConvertStakingPoolEntityToProtobuf(pool)}, nil
+}
+
+func (s *IndexHandler) ListStakingPositions(ctx context.Context, req *pb.ListStakingPositionsRequest) (*pb.ListStakingPositionsReply, error) {
+
+	if req.Staker == "" && req.Pool == "" {
+		return nil, status.Error(codes.InvalidArgument, "staker or pool is required")
+	}
+
+	positions, next, err := s.stakingRepo.QueryPositions(ctx, staking.PositionQueryOptions{
+		Staker: strings.ToLower(req.Staker),
+		Pool:   strings.ToLower(req.Pool),
+		Cursor: req.Cursor,
+		Size:   pageSize(req.Size),
+	})
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	syncBlock, err := s.syncBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type poolKey struct {
+		pool      string
+		poolSubID uint64
+	}
+
+	var (
+		pools = make(map[poolKey]*staking.StakingPool)
+		data  = make([]*pb.StakingPosition, 0, len(positions))
+	)
+	for _, position := range positions {
+		key := poolKey{pool: position.PoolAddress, poolSubID: position.PoolSubID}
+		pool, existed := pools[key]
+		if !existed {
+			if pool, err = s.stakingRepo.QueryPool(ctx, key.pool, key.poolSubID); err != nil {
+				return nil, err
+			}
+			pools[key] = pool
+		}
+
+		data = append(data, ConvertStakingPositionEntityToProtobuf(position, pool, syncBlock))
+	}
+
+	return &pb.ListStakingPositionsReply{Positions: data, NextCursor: next}, nil
+}
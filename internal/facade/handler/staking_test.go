@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertStakingPositionRewards(t *testing.T) {
+	position := staking.NewStakingPosition(100, "0xpool", 1, "0xstaker")
+	position.RewardsPerBlock = decimal.NewFromInt(2)
+	position.AccReward = decimal.NewFromInt(10)
+	position.Debt = decimal.NewFromInt(4)
+
+	reply := ConvertStakingPositionEntityToProtobuf(position, nil, 110)
+	assert.Equal(t, "26", reply.AvailableRewards)
+	assert.Equal(t, uint64(110), reply.RewardsBlock)
+
+	// rewards of a time limited pool stop at its stop block.
+	pool := &staking.StakingPool{Pool: "0xpool", PoolSubID: 1, Detail: staking.StakingPoolDetail{StopBlock: 105}}
+	reply = ConvertStakingPositionEntityToProtobuf(position, pool, 110)
+	assert.Equal(t, "16", reply.AvailableRewards)
+	assert.Equal(t, uint64(105), reply.RewardsBlock)
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/tick"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *IndexHandler) GetTick(ctx context.Context, req *pb.GetTickRequest) (*pb.GetTickReply, error) {
+
+	if req.Tick == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tick")
+	}
+
+	entity, err := s.tickRepo.Load(ctx, req.Tick)
+	if err != nil {
+		return nil, err
+	}
+
+	if entity == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	syncBlock, err := s.syncBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTickReply{Tick: ConvertTickEntityToProtobuf(entity, syncBlock)}, nil
+}
+
+func (s *IndexHandler) ListTicks(ctx context.Context, req *pb.ListTicksRequest) (*pb.ListTicksReply, error) {
+
+	switch protocol.Protocol(req.Protocol) {
+	case "", protocol.ProtocolTERC20, protocol.ProtocolIERC20, protocol.ProtocolIERCPoW:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid protocol")
+	}
+
+	entities, next, err := s.tickRepo.QueryTicks(ctx, tick.QueryOptions{
+		Protocol: protocol.Protocol(req.Protocol),
+		Prefix:   req.Prefix,
+		Cursor:   req.Cursor,
+		Size:     pageSize(req.Size),
+	})
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+
+	syncBlock, err := s.syncBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ticks = make([]*pb.Tick, 0, len(entities))
+	for _, entity := range entities {
+		ticks = append(ticks, ConvertTickEntityToProtobuf(entity, syncBlock))
+	}
+
+	return &pb.ListTicksReply{Ticks: ticks, NextCursor: next}, nil
+}
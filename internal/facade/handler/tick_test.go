@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/tick"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConvertPoWTickProjectsSupply(t *testing.T) {
+	entity := &tick.IERCPoWTick{
+		Tick:         "ethpow",
+		Protocol:     protocol.ProtocolIERCPoW,
+		Tokenomics:   []protocol.TokenomicsDetail{{BlockNumber: 100, Amount: decimal.NewFromInt(10)}},
+		Rule:         protocol.DistributionRule{PowRatio: decimal.NewFromInt(1), PosRatio: decimal.Zero},
+		MaxSupply:    decimal.NewFromInt(1000),
+		PoWSupply:    decimal.NewFromInt(20),
+		PoSSupply:    decimal.Zero,
+		PoWLastBlock: 100,
+		PoSLastBlock: 100,
+	}
+
+	reply := ConvertTickEntityToProtobuf(entity, 105)
+	detail := reply.GetIercPow()
+	assert.Equal(t, "20", reply.Supply)
+	assert.Equal(t, "980", detail.RemainSupply)
+	assert.Equal(t, "70", detail.ProjectedSupply)
+	assert.Equal(t, uint64(105), detail.ProjectedBlock)
+}
+
+func TestListTicksInvalidProtocol(t *testing.T) {
+	h := &IndexHandler{}
+
+	_, err := h.ListTicks(context.Background(), &pb.ListTicksRequest{Protocol: "brc-20"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *IndexHandler) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionReply, error) {
+
+	if req.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tx hash")
+	}
+
+	hash := strings.ToLower(req.Hash)
+	tx, err := s.blockRepo.QueryTransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	items, err := s.aggRepo.QueryEventsByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var events = make([]*pb.Event, 0, len(items))
+	for _, item := range items {
+		if event := ConvertEventEntityToProtobuf(item); event != nil {
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].PosInIercTxs < events[j].PosInIercTxs })
+
+	return &pb.GetTransactionReply{
+		Transaction: ConvertTransactionEntityToProtobuf(tx),
+		Events:      events,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertTransaction(t *testing.T) {
+	base := protocol.IERCTransactionBase{Protocol: protocol.ProtocolIERCPoW, Operate: protocol.OpMint}
+	tx := &domain.Transaction{
+		Hash:            "0x01",
+		IsProcessed:     true,
+		Code:            int32(protocol.MintBlockExpires),
+		Remark:          "block expires",
+		IERCTransaction: protocol.NewMintPoWCommand(base, "ethpi", decimal.NewFromInt(3), 100, 7),
+	}
+
+	reply := ConvertTransactionEntityToProtobuf(tx)
+	assert.Equal(t, "block expires", reply.Remark)
+	assert.Equal(t, pb.Operate_Mint, reply.IercTransaction.Operate)
+	assert.Equal(t, "ethpi", reply.IercTransaction.GetMintPow().Tick)
+	assert.Equal(t, uint64(100), reply.IercTransaction.GetMintPow().Block)
+
+	// an unparsable inscription is reported by code and remark only.
+	tx.IERCTransaction = nil
+	assert.Nil(t, ConvertTransactionEntityToProtobuf(tx).IercTransaction)
+}
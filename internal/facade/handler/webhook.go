@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errWebhookDisabled = status.Error(codes.Unimplemented, "webhooks are disabled")
+
+func (s *IndexHandler) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookReply, error) {
+
+	if !s.enableWebhook {
+		return nil, errWebhookDisabled
+	}
+
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid url")
+	}
+
+	// names are checked again by the dispatcher when they are resolved.
+	if ip := net.ParseIP(u.Hostname()); (ip != nil && !webhook.IsPublicIP(ip)) || strings.EqualFold(u.Hostname(), "localhost") {
+		return nil, status.Error(codes.InvalidArgument, "url must be a public address")
+	}
+
+	var addresses = make([]string, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		addresses = append(addresses, strings.ToLower(address))
+	}
+
+	var operates = make([]protocol.Operate, 0, len(req.Operates))
+	for _, op := range req.Operates {
+		operate, ok := protocolOperateMap[op]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid operate")
+		}
+		operates = append(operates, operate)
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		secret = webhook.NewSecret()
+	}
+
+	endpoint := &webhook.Endpoint{
+		URL:           u.String(),
+		Secret:        secret,
+		Ticks:         req.Ticks,
+		Addresses:     addresses,
+		Operates:      operates,
+		IncludeFailed: req.IncludeFailed,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := s.webhookRepo.CreateEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateWebhookReply{Webhook: ConvertWebhookEntityToProtobuf(endpoint), Secret: secret}, nil
+}
+
+func (s *IndexHandler) ListWebhooks(ctx context.Context, _ *pb.ListWebhooksRequest) (*pb.ListWebhooksReply, error) {
+
+	if !s.enableWebhook {
+		return nil, errWebhookDisabled
+	}
+
+	endpoints, err := s.webhookRepo.ListEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var data = make([]*pb.Webhook, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		data = append(data, ConvertWebhookEntityToProtobuf(endpoint))
+	}
+
+	return &pb.ListWebhooksReply{Webhooks: data}, nil
+}
+
+func (s *IndexHandler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookReply, error) {
+
+	if !s.enableWebhook {
+		return nil, errWebhookDisabled
+	}
+
+	deleted, err := s.webhookRepo.DeleteEndpoint(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deleted {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &pb.DeleteWebhookReply{}, nil
+}
@@ -0,0 +1,364 @@
+package facade
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+	"time"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/go-kratos/kratos/v2/transport/http/binding"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	sseHeartbeatInterval = 15 * time.Second
+	wsPingInterval       = 30 * time.Second
+	wsPongTimeout        = 60 * time.Second
+)
+
+// errReplied is returned when the error has been replied to the client already.
+var errReplied = errors.New("replied")
+
+// streamHandler is the streaming part of the indexer service.
+type streamHandler interface {
+	SubscribeEvent(*pb.SubscribeRequest, pb.Indexer_SubscribeEventServer) error
+	SubscribeSystemStatus(*pb.SubscribeSystemStatusRequest, pb.Indexer_SubscribeSystemStatusServer) error
+}
+
+// streamGateway serves the server streaming rpcs over Server-Sent Events and WebSocket, replies are
+// encoded by the json codec of the http server. it is installed as a filter, so that the streams
+// are not bound to the timeout of the unary routes.
+type streamGateway struct {
+	handler  streamHandler
+	logger   *log.Helper
+	routes   map[string]nethttp.HandlerFunc
+	upgrader websocket.Upgrader
+}
+
+func newStreamGateway(h streamHandler, logger log.Logger) *streamGateway {
+	g := &streamGateway{
+		handler: h,
+		logger:  log.NewHelper(log.With(logger, "module", "facade/stream")),
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *nethttp.Request) bool { return true },
+		},
+	}
+
+	g.routes = map[string]nethttp.HandlerFunc{
+		"/api/v2/index/events/sse": g.serve(g.subscribeEvent, newSSEWriter),
+		"/api/v2/index/events/ws":  g.serve(g.subscribeEvent, g.newWSWriter),
+		"/api/v2/index/status/sse": g.serve(g.subscribeSystemStatus, newSSEWriter),
+		"/api/v2/index/status/ws":  g.serve(g.subscribeSystemStatus, g.newWSWriter),
+	}
+
+	return g
+}
+
+func (g *streamGateway) Filter(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		route, ok := g.routes[r.URL.Path]
+		if !ok || r.Method != nethttp.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		route(w, r)
+	})
+}
+
+// streamWriter sends the replies of a stream to a client.
+type streamWriter interface {
+	Context() context.Context
+	Send(msg proto.Message, id string) error
+	// Close ends the stream, with the error returned by the handler if any.
+	Close(err error)
+}
+
+type subscribeFunc func(r *nethttp.Request, w streamWriter) error
+
+func (g *streamGateway) serve(subscribe subscribeFunc, newWriter func(nethttp.ResponseWriter, *nethttp.Request) (streamWriter, error)) nethttp.HandlerFunc {
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		writer, err := newWriter(w, r)
+		if errors.Is(err, errReplied) {
+			return
+		}
+		if err != nil {
+			http.DefaultErrorEncoder(w, r, err)
+			return
+		}
+
+		g.logger.Infof("stream opened. path: %s, remote: %s", r.URL.Path, r.RemoteAddr)
+		err = subscribe(r, writer)
+		g.logger.Infof("stream closed. path: %s, remote: %s, err: %v", r.URL.Path, r.RemoteAddr, err)
+
+		writer.Close(err)
+	}
+}
+
+func (g *streamGateway) subscribeEvent(r *nethttp.Request, w streamWriter) error {
+	var req pb.SubscribeRequest
+	if err := binding.BindQuery(r.URL.Query(), &req); err != nil {
+		return err
+	}
+
+	// EventSource sends the id of the last message received on reconnection.
+	if req.Cursor == "" {
+		req.Cursor = r.Header.Get("Last-Event-ID")
+	}
+
+	if err := req.Validate(); err != nil {
+		return kerrors.BadRequest("VALIDATOR", err.Error())
+	}
+
+	return g.handler.SubscribeEvent(&req, &serverStream[*pb.SubscribeReply]{
+		ctx:  w.Context(),
+		send: func(reply *pb.SubscribeReply) error { return w.Send(reply, reply.Cursor) },
+	})
+}
+
+func (g *streamGateway) subscribeSystemStatus(r *nethttp.Request, w streamWriter) error {
+	var req pb.SubscribeSystemStatusRequest
+	if err := binding.BindQuery(r.URL.Query(), &req); err != nil {
+		return err
+	}
+
+	return g.handler.SubscribeSystemStatus(&req, &serverStream[*pb.SubscribeSystemStatusReply]{
+		ctx:  w.Context(),
+		send: func(reply *pb.SubscribeSystemStatusReply) error { return w.Send(reply, "") },
+	})
+}
+
+// serverStream adapts a streamWriter to the grpc server stream of a rpc.
+type serverStream[T proto.Message] struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(T) error
+}
+
+func (s *serverStream[T]) Context() context.Context { return s.ctx }
+func (s *serverStream[T]) Send(msg T) error         { return s.send(msg) }
+
+// ============ Server-Sent Events
+
+type sseWriter struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	w       nethttp.ResponseWriter
+	r       *nethttp.Request
+	flusher nethttp.Flusher
+
+	mu      sync.Mutex
+	started bool
+	closed  bool
+}
+
+func newSSEWriter(w nethttp.ResponseWriter, r *nethttp.Request) (streamWriter, error) {
+	flusher, ok := w.(nethttp.Flusher)
+	if !ok {
+		return nil, kerrors.InternalServer("STREAM", "streaming unsupported")
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	s := &sseWriter{ctx: ctx, cancel: cancel, w: w, r: r, flusher: flusher}
+	go s.heartbeat()
+	return s, nil
+}
+
+func (s *sseWriter) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseWriter) heartbeat() {
+	ticker := time.NewTicker(sseHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.write([]byte(": ping\n\n")); err != nil {
+				s.cancel()
+				return
+			}
+		}
+	}
+}
+
+// start writes the header on the first message, so that errors before it are replied as plain http errors.
+func (s *sseWriter) start() {
+	if s.started {
+		return
+	}
+
+	s.started = true
+	s.w.Header().Set("Content-Type", "text/event-stream")
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.Header().Set("Connection", "keep-alive")
+	s.w.Header().Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(nethttp.StatusOK)
+}
+
+func (s *sseWriter) write(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.writeLocked(data)
+}
+
+func (s *sseWriter) writeLocked(data []byte) error {
+	if s.closed {
+		return context.Canceled
+	}
+
+	s.start()
+	if _, err := s.w.Write(data); err != nil {
+		return err
+	}
+
+	s.flusher.Flush()
+	return nil
+}
+
+func (s *sseWriter) Send(msg proto.Message, id string) error {
+	data, err := encoding.GetCodec(json.Name).Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if id != "" {
+		fmt.Fprintf(&buf, "id: %s\n", id)
+	}
+	fmt.Fprintf(&buf, "data: %s\n\n", data)
+
+	return s.write(buf.Bytes())
+}
+
+func (s *sseWriter) Close(err error) {
+	s.cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// nothing is written once the request is served.
+	defer func() { s.closed = true }()
+
+	if err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+
+	if !s.started {
+		http.DefaultErrorEncoder(s.w, s.r, err)
+		return
+	}
+
+	data, _ := encoding.GetCodec(json.Name).Marshal(kerrors.FromError(err))
+	_ = s.writeLocked([]byte(fmt.Sprintf("event: error\ndata: %s\n\n", data)))
+}
+
+// ============ WebSocket
+
+type wsWriter struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	conn   *websocket.Conn
+}
+
+func (g *streamGateway) newWSWriter(w nethttp.ResponseWriter, r *nethttp.Request) (streamWriter, error) {
+	conn, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		g.logger.Warnf("websocket upgrade failed. remote: %s, err: %v", r.RemoteAddr, err)
+		return nil, errReplied
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	s := &wsWriter{ctx: ctx, cancel: cancel, conn: conn}
+	go s.readLoop()
+	go s.ping()
+	return s, nil
+}
+
+func (s *wsWriter) Context() context.Context {
+	return s.ctx
+}
+
+// readLoop handles control messages and ends the stream when the client goes away.
+func (s *wsWriter) readLoop() {
+	defer s.cancel()
+
+	_ = s.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	for {
+		if _, _, err := s.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *wsWriter) ping() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second*10)); err != nil {
+				s.cancel()
+				return
+			}
+		}
+	}
+}
+
+func (s *wsWriter) Send(msg proto.Message, _ string) error {
+	data, err := encoding.GetCodec(json.Name).Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (s *wsWriter) Close(err error) {
+	defer s.conn.Close()
+	defer s.cancel()
+
+	var (
+		code   = websocket.CloseNormalClosure
+		reason string
+	)
+
+	if err != nil && !errors.Is(err, context.Canceled) {
+		se := kerrors.FromError(err)
+		reason = se.Message
+
+		switch {
+		case se.Code == nethttp.StatusBadRequest:
+			code = websocket.ClosePolicyViolation
+		case se.Code == nethttp.StatusTooManyRequests:
+			code = websocket.CloseTryAgainLater
+		default:
+			code = websocket.CloseInternalServerErr
+		}
+	}
+
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+}
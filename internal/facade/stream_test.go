@@ -0,0 +1,70 @@
+package facade
+
+import (
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/IErcOrg/IERC_Indexer/api/indexer"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeStreamHandler struct {
+	req *pb.SubscribeRequest
+}
+
+func (f *fakeStreamHandler) SubscribeEvent(req *pb.SubscribeRequest, conn pb.Indexer_SubscribeEventServer) error {
+	f.req = req
+	if err := conn.Send(&pb.SubscribeReply{BlockNumber: 10, Cursor: "c10"}); err != nil {
+		return err
+	}
+	return status.Error(codes.ResourceExhausted, "subscriber is too slow to keep up")
+}
+
+func (f *fakeStreamHandler) SubscribeSystemStatus(_ *pb.SubscribeSystemStatusRequest, conn pb.Indexer_SubscribeSystemStatusServer) error {
+	return conn.Send(&pb.SubscribeSystemStatusReply{SyncBlock: 20})
+}
+
+func TestStreamGateway(t *testing.T) {
+	var (
+		h      = &fakeStreamHandler{}
+		server = httptest.NewServer(newStreamGateway(h, log.DefaultLogger).Filter(nethttp.NotFoundHandler()))
+	)
+	defer server.Close()
+
+	req, _ := nethttp.NewRequest(nethttp.MethodGet, server.URL+"/api/v2/index/events/sse?ticks=ethi&ticks=usdt&include_failed=false", nil)
+	req.Header.Set("Last-Event-ID", "c9")
+	resp, err := nethttp.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, []string{"ethi", "usdt"}, h.req.Ticks)
+	assert.False(t, h.req.GetIncludeFailed())
+	assert.Equal(t, "c9", h.req.Cursor)
+	assert.True(t, strings.HasPrefix(string(body), "id: c10\ndata: {"))
+	assert.Contains(t, string(body), `"blockNumber":"10"`)
+	assert.Contains(t, string(body), "event: error\ndata: {\"code\":429")
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/api/v2/index/status/ws", nil)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	_, message, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Contains(t, string(message), `"syncBlock":"20"`)
+
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+
+	resp, err = nethttp.Get(server.URL + "/api/v2/index/unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, nethttp.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,327 @@
+package facade
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	nethttp "net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/webhook"
+	"github.com/IErcOrg/IERC_Indexer/internal/facade/handler"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	webhookBatchSize    = 100
+	webhookConcurrency  = 8
+	webhookTimeout      = 10 * time.Second
+	webhookPollInterval = time.Second
+	webhookEndpointsTTL = 10 * time.Second
+	webhookRetention    = 7 * 24 * time.Hour
+)
+
+type webhookEndpoint struct {
+	*webhook.Endpoint
+	filter *domain.EventFilter
+}
+
+// WebhookDispatcher follows the event log like a subscriber and writes a delivery for every webhook
+// matching a block to the outbox, together with its position. the outbox is then posted to the endpoints
+// with retries, so that deliveries survive restarts.
+type WebhookDispatcher struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	eg     *errgroup.Group
+
+	enabled bool
+	events  domain.EventRepository
+	repo    webhook.Repository
+	client  *nethttp.Client
+	notify  chan struct{}
+
+	mu          sync.Mutex
+	endpoints   map[int64]*webhookEndpoint
+	endpointsAt time.Time
+
+	logger *log.Helper
+}
+
+func NewWebhookDispatcher(config *conf.Config, events domain.EventRepository, repo webhook.Repository, logger log.Logger) *WebhookDispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	eg, gCtx := errgroup.WithContext(ctx)
+
+	return &WebhookDispatcher{
+		ctx:     gCtx,
+		cancel:  cancel,
+		eg:      eg,
+		enabled: config.Runtime.GetEnableWebhook(),
+		events:  events,
+		repo:    repo,
+		client:  newWebhookClient(),
+		notify:  make(chan struct{}, 1),
+		logger:  log.NewHelper(log.With(logger, "module", "facade/webhook")),
+	}
+}
+
+// newWebhookClient returns a client dialing only public addresses, checked after the name is resolved.
+// redirects are not followed, they count as a failed delivery.
+func newWebhookClient() *nethttp.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !webhook.IsPublicIP(ip) {
+				return fmt.Errorf("refused to dial non-public address %s", host)
+			}
+			return nil
+		},
+	}
+
+	transport := nethttp.DefaultTransport.(*nethttp.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &nethttp.Client{
+		Timeout:   webhookTimeout,
+		Transport: transport,
+		CheckRedirect: func(_ *nethttp.Request, _ []*nethttp.Request) error {
+			return nethttp.ErrUseLastResponse
+		},
+	}
+}
+
+func (d *WebhookDispatcher) Start(_ context.Context) error {
+	if !d.enabled {
+		return nil
+	}
+
+	d.logger.Info("start webhook dispatcher")
+	defer d.logger.Info("quit webhook dispatcher...")
+
+	d.eg.Go(utils.WithRetryCount(5, time.Second*15, time.Minute*3, d.enqueueLoop))
+	d.eg.Go(utils.WithRetryCount(5, time.Second*15, time.Minute*3, d.deliverLoop))
+	return d.eg.Wait()
+}
+
+func (d *WebhookDispatcher) Stop(_ context.Context) error {
+	d.cancel()
+	return d.eg.Wait()
+}
+
+func (d *WebhookDispatcher) enqueueLoop() error {
+	cursor, err := d.repo.GetCursor(d.ctx)
+	if err != nil {
+		return err
+	}
+
+	// webhooks start with the blocks handled from now on.
+	if cursor == nil {
+		lastBlock, err := d.events.GetBlockNumberByLastEvent(d.ctx)
+		if err != nil {
+			return err
+		}
+
+		start := domain.BlockCursor(lastBlock)
+		cursor = &start
+	}
+
+	stream, err := d.events.SubscribeEvent(d.ctx, *cursor, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return d.ctx.Err()
+
+		case err := <-stream.Err():
+			if err == nil {
+				return d.ctx.Err()
+			}
+			return err
+
+		case data, ok := <-stream.Next():
+			if !ok {
+				return d.ctx.Err()
+			}
+
+			if err := d.enqueue(d.ctx, data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// enqueue writes the deliveries of a block to the outbox.
+func (d *WebhookDispatcher) enqueue(ctx context.Context, data *domain.EventsByBlock) error {
+	endpoints, err := d.loadEndpoints(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		now        = time.Now()
+		deliveries []*webhook.Delivery
+	)
+
+	for _, endpoint := range endpoints {
+		matched := endpoint.filter.Apply(data)
+		if matched == nil {
+			continue
+		}
+
+		payload, err := encoding.GetCodec(json.Name).Marshal(handler.ConvertEventsByBlockToSubscribeReply(matched))
+		if err != nil {
+			return err
+		}
+
+		deliveries = append(deliveries, webhook.NewDelivery(endpoint.ID, data.BlockNumber, payload, now))
+	}
+
+	if err := d.repo.Enqueue(ctx, data.Cursor(), deliveries); err != nil {
+		return err
+	}
+
+	if len(deliveries) > 0 {
+		select {
+		case d.notify <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (d *WebhookDispatcher) loadEndpoints(ctx context.Context) (map[int64]*webhookEndpoint, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.endpoints != nil && time.Since(d.endpointsAt) < webhookEndpointsTTL {
+		return d.endpoints, nil
+	}
+
+	entities, err := d.repo.ListEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.endpoints = make(map[int64]*webhookEndpoint, len(entities))
+	for _, entity := range entities {
+		d.endpoints[entity.ID] = &webhookEndpoint{Endpoint: entity, filter: entity.Filter()}
+	}
+	d.endpointsAt = time.Now()
+
+	return d.endpoints, nil
+}
+
+func (d *WebhookDispatcher) deliverLoop() error {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	var purgedAt time.Time
+	for {
+		select {
+		case <-d.ctx.Done():
+			return d.ctx.Err()
+		case <-ticker.C:
+		case <-d.notify:
+		}
+
+		if err := d.deliverDue(d.ctx); err != nil {
+			return err
+		}
+
+		if time.Since(purgedAt) > time.Hour {
+			if err := d.repo.PurgeDeliveries(d.ctx, time.Now().Add(-webhookRetention)); err != nil {
+				return err
+			}
+			purgedAt = time.Now()
+		}
+	}
+}
+
+// deliverDue posts the due deliveries of the outbox.
+func (d *WebhookDispatcher) deliverDue(ctx context.Context) error {
+	for {
+		deliveries, err := d.repo.LoadDueDeliveries(ctx, time.Now(), webhookBatchSize)
+		if err != nil {
+			return err
+		}
+
+		endpoints, err := d.loadEndpoints(ctx)
+		if err != nil {
+			return err
+		}
+
+		eg, gCtx := errgroup.WithContext(ctx)
+		eg.SetLimit(webhookConcurrency)
+		for _, delivery := range deliveries {
+			delivery := delivery
+			eg.Go(func() error {
+				endpoint, existed := endpoints[delivery.EndpointID]
+				if !existed {
+					delivery.Fail("endpoint deleted")
+				} else if err := d.post(gCtx, endpoint.Endpoint, delivery); err != nil {
+					d.logger.Warnf("webhook delivery failed. id: %d, endpoint: %d, attempts: %d, err: %v",
+						delivery.ID, delivery.EndpointID, delivery.Attempts+1, err)
+					delivery.Retry(err, time.Now())
+				} else {
+					delivery.Delivered()
+				}
+
+				return d.repo.UpdateDelivery(gCtx, delivery)
+			})
+		}
+
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+
+		if len(deliveries) < webhookBatchSize {
+			return nil
+		}
+	}
+}
+
+func (d *WebhookDispatcher) post(ctx context.Context, endpoint *webhook.Endpoint, delivery *webhook.Delivery) error {
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Indexer-Delivery", strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set("X-Indexer-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Indexer-Signature", "sha256="+webhook.Sign(endpoint.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
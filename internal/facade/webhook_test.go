@@ -0,0 +1,139 @@
+package facade
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/webhook"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeWebhookRepo struct {
+	mu         sync.Mutex
+	endpoints  []*webhook.Endpoint
+	deliveries []*webhook.Delivery
+	cursor     *domain.EventCursor
+}
+
+func (f *fakeWebhookRepo) CreateEndpoint(_ context.Context, endpoint *webhook.Endpoint) error {
+	f.endpoints = append(f.endpoints, endpoint)
+	endpoint.ID = int64(len(f.endpoints))
+	return nil
+}
+
+func (f *fakeWebhookRepo) DeleteEndpoint(context.Context, int64) (bool, error) { return false, nil }
+
+func (f *fakeWebhookRepo) ListEndpoints(context.Context) ([]*webhook.Endpoint, error) {
+	return f.endpoints, nil
+}
+
+func (f *fakeWebhookRepo) GetCursor(context.Context) (*domain.EventCursor, error) {
+	return f.cursor, nil
+}
+
+func (f *fakeWebhookRepo) Enqueue(_ context.Context, cursor domain.EventCursor, deliveries []*webhook.Delivery) error {
+	for _, delivery := range deliveries {
+		f.deliveries = append(f.deliveries, delivery)
+		delivery.ID = int64(len(f.deliveries))
+	}
+	f.cursor = &cursor
+	return nil
+}
+
+func (f *fakeWebhookRepo) LoadDueDeliveries(_ context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	var result []*webhook.Delivery
+	for _, delivery := range f.deliveries {
+		if delivery.Status == webhook.DeliveryPending && !delivery.NextAttemptAt.After(now) && len(result) < limit {
+			copied := *delivery
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeWebhookRepo) UpdateDelivery(_ context.Context, delivery *webhook.Delivery) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	*f.deliveries[delivery.ID-1] = *delivery
+	return nil
+}
+
+func (f *fakeWebhookRepo) PurgeDeliveries(context.Context, time.Time) error { return nil }
+
+func TestWebhookDispatcher(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		received = make(chan []byte, 2)
+		fail     = true
+	)
+
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get("X-Indexer-Timestamp"), 10, 64)
+		assert.Equal(t, "sha256="+webhook.Sign("secret", timestamp, body), r.Header.Get("X-Indexer-Signature"))
+
+		if fail {
+			w.WriteHeader(nethttp.StatusInternalServerError)
+			return
+		}
+		received <- body
+	}))
+	defer server.Close()
+
+	repo := &fakeWebhookRepo{}
+	_ = repo.CreateEndpoint(ctx, &webhook.Endpoint{URL: server.URL, Secret: "secret", Ticks: []string{"ethi"}})
+	_ = repo.CreateEndpoint(ctx, &webhook.Endpoint{URL: server.URL, Secret: "secret", Operates: []protocol.Operate{protocol.OpDeploy}})
+
+	d := NewWebhookDispatcher(&conf.Config{Bootstrap: &conf.Bootstrap{Runtime: &conf.Runtime{}}}, nil, repo, log.DefaultLogger)
+
+	// the dispatcher refuses loopback endpoints, the test server is one.
+	req, _ := nethttp.NewRequest(nethttp.MethodPost, server.URL, nil)
+	_, err := d.client.Do(req)
+	assert.ErrorContains(t, err, "non-public address")
+	d.client = server.Client()
+
+	// only the first endpoint matches the block.
+	block := &domain.EventsByBlock{
+		BlockNumber: 10,
+		Events: []domain.Event{
+			&domain.IERC20TransferredEvent{
+				BlockNumber: 10,
+				Data:        &domain.IERC20Transferred{Operate: protocol.OpTransfer, Tick: "ethi", From: "0x01", To: "0x02"},
+			},
+		},
+		LastEventID: 3,
+	}
+	assert.NoError(t, d.enqueue(ctx, block))
+	assert.Equal(t, domain.EventCursor{BlockNumber: 10, EventID: 3}, *repo.cursor)
+	assert.Len(t, repo.deliveries, 1)
+
+	// the receiver fails, the delivery is retried later.
+	assert.NoError(t, d.deliverDue(ctx))
+	delivery := repo.deliveries[0]
+	assert.Equal(t, webhook.DeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+	assert.True(t, strings.Contains(delivery.LastError, "500"))
+
+	fail = false
+	delivery.NextAttemptAt = time.Now()
+	assert.NoError(t, d.deliverDue(ctx))
+	assert.Equal(t, webhook.DeliveryDelivered, delivery.Status)
+	assert.Equal(t, 2, delivery.Attempts)
+
+	body := <-received
+	assert.Contains(t, string(body), `"blockNumber":"10"`)
+	assert.Contains(t, string(body), `"cursor":"`)
+}
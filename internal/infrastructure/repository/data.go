@@ -77,12 +77,28 @@ func NewDB(c *conf.Config, l log.Logger) (*gorm.DB, func(), error) {
 			&models.Event{},
 			&models.IERCTick{},
 			&models.IERC20Balance{},
+			&models.IERC20BalanceChange{},
 			&models.StakingPool{},
 			&models.StakingPosition{},
 			&models.StakingBalance{},
+			&models.StakingBalanceChange{},
+			&models.StateJournal{},
+			&models.WebhookEndpoint{},
+			&models.WebhookDelivery{},
+			&models.WebhookCursor{},
+			&models.OutboxMessage{},
+			&models.OutboxOffset{},
+			&models.MarketListing{},
+			&models.MarketTickStats{},
+			&models.MarketTickDailyStats{},
+			&models.Migration{},
 		)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 
-	return inner, cleanup, err
+	return inner, cleanup, nil
 }
 
 type Data struct {
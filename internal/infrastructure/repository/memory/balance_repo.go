@@ -60,6 +60,94 @@ func (repo *balanceMemoryRepo) Load(ctx context.Context, key balance.BalanceKey)
 	return entity, nil
 }
 
+// LoadMany serves the cached balances and loads the missing ones with batched queries.
+func (repo *balanceMemoryRepo) LoadMany(ctx context.Context, keys []balance.BalanceKey) (map[balance.BalanceKey]*balance.Balance, error) {
+
+	var (
+		entities = make(map[balance.BalanceKey]*balance.Balance, len(keys))
+		misses   []balance.BalanceKey
+	)
+
+	for _, key := range keys {
+		entity, err := repo.getCache(key.String())
+		if err != nil {
+			misses = append(misses, key)
+			continue
+		}
+
+		entities[key] = entity
+	}
+
+	if len(misses) == 0 {
+		return entities, nil
+	}
+
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	var queries = make([]balance.BalanceKey, 0, len(misses))
+	for _, key := range misses {
+		entity, err := repo.getCache(key.String())
+		if err != nil {
+			queries = append(queries, key)
+			continue
+		}
+
+		entities[key] = entity
+	}
+
+	if len(queries) == 0 {
+		return entities, nil
+	}
+
+	loaded, err := repo.db.LoadMany(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, entity := range loaded {
+		repo.setCache(entity)
+		entities[key] = entity
+	}
+
+	return entities, nil
+}
+
+func (repo *balanceMemoryRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+	updateKind := rctx.UpdateKindFromContext(ctx)
+	switch updateKind {
+	case rctx.UpdateCache:
+		repo.mutex.Lock()
+		defer repo.mutex.Unlock()
+		return repo.cache.Reset()
+
+	case rctx.UpdateDB:
+		return repo.db.Rollback(ctx, blockNumber)
+	default:
+		return nil
+	}
+}
+
+func (repo *balanceMemoryRepo) QueryBalancesByAddress(ctx context.Context, address string, opts balance.QueryOptions) ([]*balance.Balance, string, error) {
+	return repo.db.QueryBalancesByAddress(ctx, address, opts)
+}
+
+func (repo *balanceMemoryRepo) QueryHoldersByTick(ctx context.Context, tick string, opts balance.QueryOptions) ([]*balance.Balance, string, error) {
+	return repo.db.QueryHoldersByTick(ctx, tick, opts)
+}
+
+func (repo *balanceMemoryRepo) LoadAt(ctx context.Context, key balance.BalanceKey, blockNumber uint64) (*balance.Balance, error) {
+	return repo.db.LoadAt(ctx, key, blockNumber)
+}
+
+func (repo *balanceMemoryRepo) QueryHoldersAt(ctx context.Context, tick string, blockNumber uint64) ([]*balance.Balance, error) {
+	return repo.db.QueryHoldersAt(ctx, tick, blockNumber)
+}
+
+func (repo *balanceMemoryRepo) QueryChanges(ctx context.Context, address string, opts balance.ChangeQueryOptions) ([]*balance.Change, string, error) {
+	return repo.db.QueryChanges(ctx, address, opts)
+}
+
 func (repo *balanceMemoryRepo) updateCache(entities ...*balance.Balance) error {
 	repo.mutex.Lock()
 	defer repo.mutex.Unlock()
@@ -81,8 +169,8 @@ func (repo *balanceMemoryRepo) setCache(entity *balance.Balance) {
 		return
 	}
 
-	key := fmt.Sprintf("%s_%s", BalanceCacheKeyPrefix, entity.Key())
-	_ = repo.cache.Set(key, bytes)
+	key := entity.Key()
+	_ = repo.cache.Set(fmt.Sprintf("%s_%s", BalanceCacheKeyPrefix, key.String()), bytes)
 }
 
 func (repo *balanceMemoryRepo) getCache(key string) (*balance.Balance, error) {
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	rctx "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/context"
+	"github.com/allegro/bigcache"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeBalanceRepo struct {
+	balance.BalanceRepository
+	rows    map[balance.BalanceKey]*balance.Balance
+	queries [][]balance.BalanceKey
+}
+
+func (f *fakeBalanceRepo) LoadMany(_ context.Context, keys []balance.BalanceKey) (map[balance.BalanceKey]*balance.Balance, error) {
+	f.queries = append(f.queries, keys)
+
+	result := make(map[balance.BalanceKey]*balance.Balance)
+	for _, key := range keys {
+		if entity, ok := f.rows[key]; ok {
+			result[key] = entity
+		}
+	}
+	return result, nil
+}
+
+func TestBalanceLoadManyPartialHit(t *testing.T) {
+	cache, err := bigcache.NewBigCache(bigcache.DefaultConfig(time.Minute))
+	assert.NoError(t, err)
+
+	newBalance := func(id int64, address string) *balance.Balance {
+		return &balance.Balance{ID: id, Address: address, Tick: "ethi", Available: decimal.NewFromInt(id), Freeze: decimal.Zero, MintedAmount: decimal.Zero}
+	}
+
+	var (
+		cached  = newBalance(1, "0x01")
+		stored  = newBalance(2, "0x02")
+		missing = balance.NewBalanceKey("0x03", "ethi")
+		db      = &fakeBalanceRepo{rows: map[balance.BalanceKey]*balance.Balance{stored.Key(): stored}}
+		repo    = NewBalanceMemoryRepository(db, cache)
+	)
+
+	ctx := rctx.WithUpdateKind(context.Background(), rctx.UpdateCache)
+	assert.NoError(t, repo.Save(ctx, cached))
+
+	entities, err := repo.LoadMany(context.Background(), []balance.BalanceKey{cached.Key(), stored.Key(), missing})
+	assert.NoError(t, err)
+	assert.Len(t, entities, 2)
+	assert.True(t, entities[cached.Key()].Available.Equal(cached.Available))
+	assert.True(t, entities[stored.Key()].Available.Equal(stored.Available))
+	assert.Equal(t, [][]balance.BalanceKey{{stored.Key(), missing}}, db.queries)
+
+	// the loaded balance is cached now.
+	_, err = repo.LoadMany(context.Background(), []balance.BalanceKey{stored.Key()})
+	assert.NoError(t, err)
+	assert.Len(t, db.queries, 1)
+}
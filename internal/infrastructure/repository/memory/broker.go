@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
+)
+
+// Broker keeps the published messages in memory by key, a stand-in of the message broker for tests and local runs.
+type Broker struct {
+	mu       sync.Mutex
+	messages map[string][]*outbox.Message
+}
+
+func NewBroker() *Broker {
+	return &Broker{messages: make(map[string][]*outbox.Message)}
+}
+
+func (b *Broker) Publish(_ context.Context, messages []*outbox.Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, message := range messages {
+		stored := b.messages[message.Key]
+		if len(stored) > 0 && message.ID <= stored[len(stored)-1].ID {
+			continue
+		}
+
+		b.messages[message.Key] = append(stored, message)
+	}
+
+	return nil
+}
+
+// Messages returns the messages of a key in the order they were published.
+func (b *Broker) Messages(key string) []*outbox.Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]*outbox.Message(nil), b.messages[key]...)
+}
+
+func (b *Broker) Close() error {
+	return nil
+}
@@ -43,6 +43,46 @@ func (s *stakingMemoryRepo) Save(ctx context.Context, blockNumber uint64, pools
 	}
 }
 
+func (s *stakingMemoryRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+	updateKind := rctx.UpdateKindFromContext(ctx)
+	switch updateKind {
+	case rctx.UpdateCache:
+		roots, err := s.repo.LoadAllPools(ctx)
+		if err != nil {
+			return err
+		}
+
+		if roots == nil {
+			roots = make(map[string]*staking.PoolAggregate)
+		}
+
+		s.pools = roots
+		return nil
+
+	case rctx.UpdateDB:
+		return s.repo.Rollback(ctx, blockNumber)
+
+	default:
+		return nil
+	}
+}
+
+func (s *stakingMemoryRepo) QueryPools(ctx context.Context, opts staking.PoolQueryOptions) ([]*staking.StakingPool, string, error) {
+	return s.repo.QueryPools(ctx, opts)
+}
+
+func (s *stakingMemoryRepo) QueryPool(ctx context.Context, pool string, poolSubID uint64) (*staking.StakingPool, error) {
+	return s.repo.QueryPool(ctx, pool, poolSubID)
+}
+
+func (s *stakingMemoryRepo) QueryPositions(ctx context.Context, opts staking.PositionQueryOptions) ([]*staking.StakingPosition, string, error) {
+	return s.repo.QueryPositions(ctx, opts)
+}
+
+func (s *stakingMemoryRepo) QueryStakedBalancesAt(ctx context.Context, tick string, blockNumber uint64) ([]*staking.StakedBalance, error) {
+	return s.repo.QueryStakedBalancesAt(ctx, tick, blockNumber)
+}
+
 func NewStakingMemoryRepository(repo staking.StakingRepository) (staking.StakingRepository, error) {
 
 	ctx := context.Background()
@@ -68,6 +68,79 @@ func (repo *tickMemoryRepo) Load(ctx context.Context, tickName string) (tick.Tic
 	return entity, nil
 }
 
+// LoadMany serves the cached ticks and loads the missing ones with a single query.
+func (repo *tickMemoryRepo) LoadMany(ctx context.Context, names []string) (map[string]tick.Tick, error) {
+
+	var (
+		entities = make(map[string]tick.Tick, len(names))
+		misses   []string
+	)
+
+	for _, name := range names {
+		entity, err := repo.getCache(name)
+		if err != nil {
+			misses = append(misses, name)
+			continue
+		}
+
+		entities[name] = entity
+	}
+
+	if len(misses) == 0 {
+		return entities, nil
+	}
+
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	var queries = make([]string, 0, len(misses))
+	for _, name := range misses {
+		entity, err := repo.getCache(name)
+		if err != nil {
+			queries = append(queries, name)
+			continue
+		}
+
+		entities[name] = entity
+	}
+
+	if len(queries) == 0 {
+		return entities, nil
+	}
+
+	loaded, err := repo.db.LoadMany(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, entity := range loaded {
+		repo.setCache(entity)
+		entities[name] = entity
+	}
+
+	return entities, nil
+}
+
+func (repo *tickMemoryRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+
+	updateKind := rctx.UpdateKindFromContext(ctx)
+	switch updateKind {
+	case rctx.UpdateCache:
+		repo.mutex.Lock()
+		defer repo.mutex.Unlock()
+		return repo.cache.Reset()
+
+	case rctx.UpdateDB:
+		return repo.db.Rollback(ctx, blockNumber)
+	default:
+		return nil
+	}
+}
+
+func (repo *tickMemoryRepo) QueryTicks(ctx context.Context, opts tick.QueryOptions) ([]tick.Tick, string, error) {
+	return repo.db.QueryTicks(ctx, opts)
+}
+
 func (repo *tickMemoryRepo) updateCache(entities ...tick.Tick) error {
 	repo.mutex.Lock()
 	defer repo.mutex.Unlock()
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	mysqlimpl "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// Migrator seeds the projections added over an indexed database. seeding replays the indexed
+// state, it is run by the indexer before it starts or by cmd/migrate, never by the other tools.
+type Migrator struct {
+	db     *gorm.DB
+	logger *log.Helper
+}
+
+func NewMigrator(db *gorm.DB, logger log.Logger) *Migrator {
+	return &Migrator{db: db, logger: log.NewHelper(log.With(logger, "module", "repository/migrator"))}
+}
+
+// Pending returns the names of the migrations not run yet.
+func (m *Migrator) Pending(ctx context.Context) ([]string, error) {
+	migrations, err := mysqlimpl.PendingMigrations(m.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var names = make([]string, 0, len(migrations))
+	for _, migration := range migrations {
+		names = append(names, migration.Name)
+	}
+
+	return names, nil
+}
+
+func (m *Migrator) Migrate(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	migrations, err := mysqlimpl.PendingMigrations(db)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		m.logger.Infof("run migration %s", migration.Name)
+		if err := mysqlimpl.RunMigration(db, migration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -32,3 +32,33 @@ func ConvertBalanceModelToEntity(b *models.IERC20Balance) *balance.Balance {
 		UpdatedAt:        b.UpdatedAt,
 	}
 }
+
+func ConvertBalanceChangeToModel(change *balance.Change) *models.IERC20BalanceChange {
+	return &models.IERC20BalanceChange{
+		ID:             change.ID,
+		Address:        change.Address,
+		Tick:           change.Tick,
+		BlockNumber:    change.BlockNumber,
+		Available:      change.Available,
+		Freeze:         change.Freeze,
+		Minted:         change.MintedAmount,
+		AvailableDelta: change.AvailableDelta,
+		FreezeDelta:    change.FreezeDelta,
+		MintedDelta:    change.MintedDelta,
+	}
+}
+
+func ConvertBalanceChangeModelToEntity(m *models.IERC20BalanceChange) *balance.Change {
+	return &balance.Change{
+		ID:             m.ID,
+		Address:        m.Address,
+		Tick:           m.Tick,
+		BlockNumber:    m.BlockNumber,
+		Available:      m.Available,
+		Freeze:         m.Freeze,
+		MintedAmount:   m.Minted,
+		AvailableDelta: m.AvailableDelta,
+		FreezeDelta:    m.FreezeDelta,
+		MintedDelta:    m.MintedDelta,
+	}
+}
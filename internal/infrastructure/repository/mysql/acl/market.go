@@ -0,0 +1,142 @@
+package acl
+
+import (
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/market"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertListingEntityToModel(listing *market.Listing) *models.MarketListing {
+	return &models.MarketListing{
+		ID:           0,
+		TxHash:       listing.TxHash,
+		Position:     listing.Position,
+		Sign:         listing.Sign,
+		Protocol:     string(listing.Protocol),
+		Tick:         listing.Tick,
+		Seller:       listing.Seller,
+		Platform:     listing.Platform,
+		Amount:       listing.Amount,
+		Value:        listing.Value,
+		Price:        listing.Price,
+		Status:       uint8(listing.Status),
+		ListedBlock:  listing.ListedBlock,
+		Buyer:        listing.Buyer,
+		ClosedTxHash: listing.ClosedTxHash,
+		ClosedBlock:  listing.ClosedBlock,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+func ConvertListingModelToEntity(m *models.MarketListing) *market.Listing {
+	return &market.Listing{
+		ID:           m.ID,
+		TxHash:       m.TxHash,
+		Position:     m.Position,
+		Sign:         m.Sign,
+		Protocol:     protocol.Protocol(m.Protocol),
+		Tick:         m.Tick,
+		Seller:       m.Seller,
+		Platform:     m.Platform,
+		Amount:       m.Amount,
+		Value:        m.Value,
+		Price:        m.Price,
+		Status:       market.ListingStatus(m.Status),
+		ListedBlock:  m.ListedBlock,
+		Buyer:        m.Buyer,
+		ClosedTxHash: m.ClosedTxHash,
+		ClosedBlock:  m.ClosedBlock,
+	}
+}
+
+func ConvertTickStatsEntityToModel(s *market.TickStats) *models.MarketTickStats {
+	return &models.MarketTickStats{
+		ID:             0,
+		Tick:           s.Tick,
+		BlockNumber:    s.BlockNumber,
+		Day:            s.Day,
+		HolderDelta:    s.HolderDelta,
+		Transfers:      s.Transfers,
+		Mints:          s.Mints,
+		Minted:         s.Minted,
+		Trades:         s.Trades,
+		Volume:         s.Volume,
+		Holders:        s.Holders,
+		TotalTransfers: s.TotalTransfers,
+		TotalMints:     s.TotalMints,
+		TotalMinted:    s.TotalMinted,
+		TotalTrades:    s.TotalTrades,
+		TotalVolume:    s.TotalVolume,
+		LastPrice:      s.LastPrice,
+		CreatedAt:      time.Now(),
+	}
+}
+
+func ConvertTickStatsModelToEntity(m *models.MarketTickStats) *market.TickStats {
+	return &market.TickStats{
+		Tick:           m.Tick,
+		BlockNumber:    m.BlockNumber,
+		Day:            m.Day,
+		HolderDelta:    m.HolderDelta,
+		Transfers:      m.Transfers,
+		Mints:          m.Mints,
+		Minted:         m.Minted,
+		Trades:         m.Trades,
+		Volume:         m.Volume,
+		Holders:        m.Holders,
+		TotalTransfers: m.TotalTransfers,
+		TotalMints:     m.TotalMints,
+		TotalMinted:    m.TotalMinted,
+		TotalTrades:    m.TotalTrades,
+		TotalVolume:    m.TotalVolume,
+		LastPrice:      m.LastPrice,
+	}
+}
+
+func ConvertDailyStatsEntityToModel(s *market.TickStats) *models.MarketTickDailyStats {
+	return &models.MarketTickDailyStats{
+		ID:             0,
+		Tick:           s.Tick,
+		Day:            s.Day,
+		BlockNumber:    s.BlockNumber,
+		HolderDelta:    s.HolderDelta,
+		Transfers:      s.Transfers,
+		Mints:          s.Mints,
+		Minted:         s.Minted,
+		Trades:         s.Trades,
+		Volume:         s.Volume,
+		Holders:        s.Holders,
+		TotalTransfers: s.TotalTransfers,
+		TotalMints:     s.TotalMints,
+		TotalMinted:    s.TotalMinted,
+		TotalTrades:    s.TotalTrades,
+		TotalVolume:    s.TotalVolume,
+		LastPrice:      s.LastPrice,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+func ConvertDailyStatsModelToEntity(m *models.MarketTickDailyStats) *market.TickStats {
+	return &market.TickStats{
+		Tick:           m.Tick,
+		BlockNumber:    m.BlockNumber,
+		Day:            m.Day,
+		HolderDelta:    m.HolderDelta,
+		Transfers:      m.Transfers,
+		Mints:          m.Mints,
+		Minted:         m.Minted,
+		Trades:         m.Trades,
+		Volume:         m.Volume,
+		Holders:        m.Holders,
+		TotalTransfers: m.TotalTransfers,
+		TotalMints:     m.TotalMints,
+		TotalMinted:    m.TotalMinted,
+		TotalTrades:    m.TotalTrades,
+		TotalVolume:    m.TotalVolume,
+		LastPrice:      m.LastPrice,
+	}
+}
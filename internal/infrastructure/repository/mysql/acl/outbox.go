@@ -0,0 +1,25 @@
+package acl
+
+import (
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertOutboxMessageToModel(message *outbox.Message) *models.OutboxMessage {
+	return &models.OutboxMessage{
+		ID:          message.ID,
+		Key:         message.Key,
+		BlockNumber: message.BlockNumber,
+		Payload:     message.Payload,
+	}
+}
+
+func ConvertOutboxMessageModelToEntity(m *models.OutboxMessage) *outbox.Message {
+	return &outbox.Message{
+		ID:          m.ID,
+		Key:         m.Key,
+		BlockNumber: m.BlockNumber,
+		Payload:     m.Payload,
+		CreatedAt:   m.CreatedAt,
+	}
+}
@@ -86,3 +86,24 @@ func ConvertPositionEntityToBalanceModel(position *staking.StakingPosition) map[
 
 	return balancesMap
 }
+
+func ConvertStakingBalanceToChangeModel(m *models.StakingBalance, blockNumber uint64) *models.StakingBalanceChange {
+	return &models.StakingBalanceChange{
+		Staker:      m.Staker,
+		Pool:        m.Pool,
+		PoolID:      m.PoolID,
+		Tick:        m.Tick,
+		BlockNumber: blockNumber,
+		Amount:      m.Amount,
+	}
+}
+
+func ConvertStakingBalanceChangeModelToEntity(m *models.StakingBalanceChange) *staking.StakedBalance {
+	return &staking.StakedBalance{
+		Staker:    m.Staker,
+		Pool:      m.Pool,
+		PoolSubID: m.PoolID,
+		Tick:      m.Tick,
+		Amount:    m.Amount,
+	}
+}
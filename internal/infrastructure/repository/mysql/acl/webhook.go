@@ -0,0 +1,74 @@
+package acl
+
+import (
+	"encoding/json"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/webhook"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+)
+
+type webhookFilter struct {
+	Ticks         []string           `json:"ticks,omitempty"`
+	Addresses     []string           `json:"addresses,omitempty"`
+	Operates      []protocol.Operate `json:"operates,omitempty"`
+	IncludeFailed bool               `json:"include_failed,omitempty"`
+}
+
+func ConvertWebhookEndpointToModel(endpoint *webhook.Endpoint) *models.WebhookEndpoint {
+	filter, _ := json.Marshal(webhookFilter{
+		Ticks:         endpoint.Ticks,
+		Addresses:     endpoint.Addresses,
+		Operates:      endpoint.Operates,
+		IncludeFailed: endpoint.IncludeFailed,
+	})
+
+	return &models.WebhookEndpoint{
+		ID:     endpoint.ID,
+		URL:    endpoint.URL,
+		Secret: endpoint.Secret,
+		Filter: filter,
+	}
+}
+
+func ConvertWebhookEndpointModelToEntity(m *models.WebhookEndpoint) *webhook.Endpoint {
+	var filter webhookFilter
+	_ = json.Unmarshal(m.Filter, &filter)
+
+	return &webhook.Endpoint{
+		ID:            m.ID,
+		URL:           m.URL,
+		Secret:        m.Secret,
+		Ticks:         filter.Ticks,
+		Addresses:     filter.Addresses,
+		Operates:      filter.Operates,
+		IncludeFailed: filter.IncludeFailed,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+func ConvertWebhookDeliveryToModel(delivery *webhook.Delivery) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:            delivery.ID,
+		EndpointID:    delivery.EndpointID,
+		BlockNumber:   delivery.BlockNumber,
+		Payload:       delivery.Payload,
+		Status:        uint8(delivery.Status),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     delivery.LastError,
+	}
+}
+
+func ConvertWebhookDeliveryModelToEntity(m *models.WebhookDelivery) *webhook.Delivery {
+	return &webhook.Delivery{
+		ID:            m.ID,
+		EndpointID:    m.EndpointID,
+		BlockNumber:   m.BlockNumber,
+		Payload:       m.Payload,
+		Status:        webhook.DeliveryStatus(m.Status),
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+	}
+}
@@ -3,15 +3,27 @@ package mysqlimpl
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
 	rctx "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/context"
 	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/acl"
 	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+var balanceJournalSchema = &journalSchema[models.IERC20Balance]{
+	table:   (&models.IERC20Balance{}).TableName(),
+	columns: []string{`address`, `tick`},
+	key:     func(m *models.IERC20Balance) []any { return []any{m.Address, m.Tick} },
+}
+
+const balanceTotalExpr = "(available + freeze)"
+
 type balanceMySQLRepo struct {
 	db *gorm.DB
 }
@@ -35,6 +47,29 @@ func (repo *balanceMySQLRepo) Load(ctx context.Context, key balance.BalanceKey)
 	return acl.ConvertBalanceModelToEntity(&m), nil
 }
 
+func (repo *balanceMySQLRepo) LoadMany(ctx context.Context, keys []balance.BalanceKey) (map[balance.BalanceKey]*balance.Balance, error) {
+	var entities = make(map[balance.BalanceKey]*balance.Balance, len(keys))
+
+	for _, batch := range chunk(keys, loadBatchSize) {
+		var values = make([][]any, 0, len(batch))
+		for _, key := range batch {
+			values = append(values, []any{key.Address, key.Tick})
+		}
+
+		var ms []*models.IERC20Balance
+		if err := repo.db.WithContext(ctx).Where("(address, tick) IN ?", values).Find(&ms).Error; err != nil {
+			return nil, err
+		}
+
+		for _, m := range ms {
+			entity := acl.ConvertBalanceModelToEntity(m)
+			entities[entity.Key()] = entity
+		}
+	}
+
+	return entities, nil
+}
+
 func (repo *balanceMySQLRepo) Save(ctx context.Context, entities ...*balance.Balance) error {
 	if len(entities) == 0 {
 		return nil
@@ -45,9 +80,29 @@ func (repo *balanceMySQLRepo) Save(ctx context.Context, entities ...*balance.Bal
 		panic("missing db instance")
 	}
 
-	var ms []*models.IERC20Balance
+	var (
+		ms      []*models.IERC20Balance
+		msBlock = make(map[uint64][]*models.IERC20Balance)
+	)
 	for _, entity := range entities {
-		ms = append(ms, acl.ConvertBalanceEntityToModel(entity))
+		m := acl.ConvertBalanceEntityToModel(entity)
+		ms = append(ms, m)
+		msBlock[m.LastUpdatedBlock] = append(msBlock[m.LastUpdatedBlock], m)
+	}
+
+	for blockNumber, items := range msBlock {
+		existed, err := loadRows(db, balanceJournalSchema, items)
+		if err != nil {
+			return err
+		}
+
+		if err := createJournals(db, balanceJournalSchema, blockNumber, items, existed); err != nil {
+			return err
+		}
+
+		if err := saveBalanceChanges(db, items, existed); err != nil {
+			return err
+		}
 	}
 
 	return db.Clauses(clause.OnConflict{
@@ -61,3 +116,270 @@ func (repo *balanceMySQLRepo) Save(ctx context.Context, entities ...*balance.Bal
 		}),
 	}).CreateInBatches(ms, 1000).Error
 }
+
+func (repo *balanceMySQLRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	if err := rollbackJournals(db, balanceJournalSchema, blockNumber); err != nil {
+		return err
+	}
+
+	return rollbackBalanceChanges(db, blockNumber)
+}
+
+func (repo *balanceMySQLRepo) QueryBalancesByAddress(ctx context.Context, address string, opts balance.QueryOptions) ([]*balance.Balance, string, error) {
+	db := repo.db.WithContext(ctx).Where("address = ?", address)
+	if opts.OrderByTotal {
+		return repo.queryByTotal(db, opts)
+	}
+
+	db = db.Order("tick ASC")
+	if opts.Cursor != "" {
+		values, err := utils.DecodeCursor(opts.Cursor, 1)
+		if err != nil {
+			return nil, "", err
+		}
+		db = db.Where("tick > ?", values[0])
+	}
+
+	entities, err := repo.queryPage(db, opts.Size)
+	if err != nil || len(entities) < opts.Size+1 {
+		return entities, "", err
+	}
+
+	entities = entities[:opts.Size]
+	return entities, utils.EncodeCursor(entities[len(entities)-1].Tick), nil
+}
+
+func (repo *balanceMySQLRepo) QueryHoldersByTick(ctx context.Context, tick string, opts balance.QueryOptions) ([]*balance.Balance, string, error) {
+	db := repo.db.WithContext(ctx).Where("tick = ? and "+balanceTotalExpr+" > 0", tick)
+	return repo.queryByTotal(db, opts)
+}
+
+// queryByTotal pages balances by total desc, ties are broken by id.
+func (repo *balanceMySQLRepo) queryByTotal(db *gorm.DB, opts balance.QueryOptions) ([]*balance.Balance, string, error) {
+	db = db.Order(balanceTotalExpr + " DESC").Order("id ASC")
+	if opts.Cursor != "" {
+		values, err := utils.DecodeCursor(opts.Cursor, 2)
+		if err != nil {
+			return nil, "", err
+		}
+
+		total, err := decimal.NewFromString(values[0])
+		if err != nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+
+		id, err := strconv.ParseInt(values[1], 10, 64)
+		if err != nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+
+		db = db.Where(balanceTotalExpr+" < ? or ("+balanceTotalExpr+" = ? and id > ?)", total, total, id)
+	}
+
+	entities, err := repo.queryPage(db, opts.Size)
+	if err != nil || len(entities) < opts.Size+1 {
+		return entities, "", err
+	}
+
+	entities = entities[:opts.Size]
+	last := entities[len(entities)-1]
+	return entities, utils.EncodeCursor(last.Total().String(), strconv.FormatInt(last.ID, 10)), nil
+}
+
+// queryPage loads one more row than size to tell whether a next page exists.
+func (repo *balanceMySQLRepo) queryPage(db *gorm.DB, size int) ([]*balance.Balance, error) {
+	var ms []*models.IERC20Balance
+	if err := db.Limit(size + 1).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var entities = make([]*balance.Balance, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertBalanceModelToEntity(m))
+	}
+
+	return entities, nil
+}
+
+func (repo *balanceMySQLRepo) LoadAt(ctx context.Context, key balance.BalanceKey, blockNumber uint64) (*balance.Balance, error) {
+	db := repo.db.WithContext(ctx).Where("address = ? and tick = ?", key.Address, key.Tick)
+
+	var m models.IERC20BalanceChange
+	err := db.Session(&gorm.Session{}).Where("block_number <= ?", blockNumber).Order("block_number DESC").Take(&m).Error
+	if err == nil {
+		return acl.ConvertBalanceChangeModelToEntity(&m).Balance(), nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// without a change up to the block, the balance did not exist unless its history starts with a snapshot.
+	err = db.Session(&gorm.Session{}).Where("block_number > ?", blockNumber).Order("block_number ASC").Take(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if m.Snapshot {
+		return nil, balance.ErrHistoryUnavailable
+	}
+
+	return nil, nil
+}
+
+func (repo *balanceMySQLRepo) QueryHoldersAt(ctx context.Context, tick string, blockNumber uint64) ([]*balance.Balance, error) {
+	db := repo.db.WithContext(ctx)
+
+	// snapshots are the first change of a balance, one above the block hides the balance at the block.
+	var snapshotBlock uint64
+	err := db.Model(&models.IERC20BalanceChange{}).
+		Select("COALESCE(MAX(block_number), 0)").
+		Where("tick = ? and snapshot = ?", tick, true).
+		Scan(&snapshotBlock).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshotBlock > blockNumber {
+		return nil, fmt.Errorf("%w, oldest height: %d", balance.ErrHistoryUnavailable, snapshotBlock)
+	}
+
+	latest := db.Model(&models.IERC20BalanceChange{}).
+		Select("address, MAX(block_number) AS block_number").
+		Where("tick = ? and block_number <= ?", tick, blockNumber).
+		Group("address")
+
+	var ms []*models.IERC20BalanceChange
+	err = db.Table("? AS c", clause.Table{Name: (&models.IERC20BalanceChange{}).TableName()}).
+		Select("c.*").
+		Joins("JOIN (?) AS l ON c.address = l.address AND c.block_number = l.block_number", latest).
+		Where("c.tick = ? and (c.available + c.freeze) > 0", tick).
+		Order("c.address ASC").
+		Find(&ms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var entities = make([]*balance.Balance, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertBalanceChangeModelToEntity(m).Balance())
+	}
+
+	return entities, nil
+}
+
+func (repo *balanceMySQLRepo) QueryChanges(ctx context.Context, address string, opts balance.ChangeQueryOptions) ([]*balance.Change, string, error) {
+	db := repo.db.WithContext(ctx).
+		Where("address = ? and snapshot = ?", address, false).
+		Order("block_number DESC").
+		Order("id DESC")
+
+	if opts.Tick != "" {
+		db = db.Where("tick = ?", opts.Tick)
+	}
+
+	if opts.Cursor != "" {
+		values, err := utils.DecodeCursor(opts.Cursor, 2)
+		if err != nil {
+			return nil, "", err
+		}
+
+		blockNumber, err := strconv.ParseUint(values[0], 10, 64)
+		if err != nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+
+		id, err := strconv.ParseInt(values[1], 10, 64)
+		if err != nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+
+		db = db.Where("block_number < ? or (block_number = ? and id < ?)", blockNumber, blockNumber, id)
+	}
+
+	var ms []*models.IERC20BalanceChange
+	if err := db.Limit(opts.Size + 1).Find(&ms).Error; err != nil {
+		return nil, "", err
+	}
+
+	var changes = make([]*balance.Change, 0, len(ms))
+	for _, m := range ms {
+		changes = append(changes, acl.ConvertBalanceChangeModelToEntity(m))
+	}
+
+	if len(changes) < opts.Size+1 {
+		return changes, "", nil
+	}
+
+	changes = changes[:opts.Size]
+	last := changes[len(changes)-1]
+	return changes, utils.EncodeCursor(strconv.FormatUint(last.BlockNumber, 10), strconv.FormatInt(last.ID, 10)), nil
+}
+
+// saveBalanceChanges records the balances changed by a block in the ledger, existed are the rows before the block.
+func saveBalanceChanges(db *gorm.DB, ms []*models.IERC20Balance, existed map[string]*models.IERC20Balance) error {
+	var changes = make([]*models.IERC20BalanceChange, 0, len(ms))
+	for _, m := range ms {
+		var before *balance.Balance
+		if item, ok := existed[balanceJournalSchema.keyString(m)]; ok {
+			before = acl.ConvertBalanceModelToEntity(item)
+		}
+
+		change := balance.NewChange(before, acl.ConvertBalanceModelToEntity(m))
+		changes = append(changes, acl.ConvertBalanceChangeToModel(change))
+	}
+
+	// a snapshot of the same block is replaced.
+	return db.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(changes, 1000).Error
+}
+
+const balanceSnapshotSQL = "INSERT INTO `ierc_balance_changes` " +
+	"(`address`, `tick`, `block_number`, `available`, `freeze`, `minted`, `snapshot`, `created_at`) " +
+	"SELECT `address`, `tick`, `last_updated_block`, `available`, `freeze`, `minted`, true, NOW(3) FROM `ierc_balances`"
+
+// SeedBalanceChanges records the existing balances as snapshots, when the ledger is created over an indexed database.
+func SeedBalanceChanges(db *gorm.DB) error {
+	if err := db.Where("1 = 1").Delete(&models.IERC20BalanceChange{}).Error; err != nil {
+		return err
+	}
+
+	return db.Exec(balanceSnapshotSQL).Error
+}
+
+// rollbackBalanceChanges deletes the changes above the block, the snapshots deleted are taken again
+// from the balances restored by the journals.
+func rollbackBalanceChanges(db *gorm.DB, blockNumber uint64) error {
+	var keys []*models.IERC20BalanceChange
+	err := db.Select("address", "tick").
+		Where("block_number > ? and snapshot = ?", blockNumber, true).
+		Find(&keys).Error
+	if err != nil {
+		return err
+	}
+
+	if err := db.Where("block_number > ?", blockNumber).Delete(&models.IERC20BalanceChange{}).Error; err != nil {
+		return err
+	}
+
+	for _, batch := range chunk(keys, loadBatchSize) {
+		var values = make([][]any, 0, len(batch))
+		for _, key := range batch {
+			values = append(values, []any{key.Address, key.Tick})
+		}
+
+		if err := db.Exec(balanceSnapshotSQL+" WHERE (`address`, `tick`) IN ?", values).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
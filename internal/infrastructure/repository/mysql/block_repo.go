@@ -113,6 +113,29 @@ func (repo *blockMySQLRepo) QueryLastProcessedBlock(ctx context.Context, blockNu
 	}, nil
 }
 
+func (repo *blockMySQLRepo) QueryBlockHeaderByNumber(ctx context.Context, blockNumber uint64) (*domain.BlockHeader, error) {
+
+	var block models.Block
+	err := repo.db.WithContext(ctx).
+		Table(block.TableName()).
+		Where("block_number = ?", blockNumber).
+		Take(&block).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &domain.BlockHeader{
+		Number:     block.Number,
+		Hash:       block.Hash,
+		ParentHash: block.ParentHash,
+	}, nil
+}
+
 func (repo *blockMySQLRepo) GetPendingBlocksWithTransactionsByNumber(ctx context.Context, number uint64, bulkSize int) ([]*domain.Block, error) {
 	var (
 		block  models.Block
@@ -210,7 +233,7 @@ func (repo *blockMySQLRepo) QueryTransactionByHash(ctx context.Context, hash str
 	err := repo.db.WithContext(ctx).Table(m.TableName()).Where("hash = ?", hash).Take(&m).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("not found")
+			return nil, nil
 		}
 
 		return nil, err
@@ -307,3 +330,39 @@ func (repo *blockMySQLRepo) Update(ctx context.Context, block *domain.Block) err
 		DoUpdates: clause.AssignmentColumns([]string{`is_processed`, `code`, `remark`, `updated_at`}),
 	}).CreateInBatches(transactions, 1000).Error
 }
+
+func (repo *blockMySQLRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	err := dbWithTx.Where("block_number > ?", blockNumber).Delete(&models.Transaction{}).Error
+	if err != nil {
+		return err
+	}
+
+	return dbWithTx.Where("block_number > ?", blockNumber).Delete(&models.Block{}).Error
+}
+
+func (repo *blockMySQLRepo) Reset(ctx context.Context, blockNumber uint64) error {
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	err := dbWithTx.Table((&models.Transaction{}).TableName()).
+		Where("block_number > ?", blockNumber).
+		Updates(map[string]any{"is_processed": false, "code": 0, "remark": ""}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return dbWithTx.Table((&models.Block{}).TableName()).
+		Where("block_number > ? and tx_count > 0", blockNumber).
+		Update("is_processed", false).
+		Error
+}
@@ -15,14 +15,14 @@ import (
 type eventRepo struct {
 	db *gorm.DB
 
-	subscriber map[string]*domain.Stream[domain.EventsByBlock]
+	subscriber map[string]*subscription
 	rw         sync.Mutex
 }
 
 func NewEventRepository(db *gorm.DB) domain.EventRepository {
 	return &eventRepo{
 		db:         db,
-		subscriber: make(map[string]*domain.Stream[domain.EventsByBlock]),
+		subscriber: make(map[string]*subscription),
 		rw:         sync.Mutex{},
 	}
 }
@@ -45,97 +45,75 @@ func (repo *eventRepo) GetBlockNumberByLastEvent(ctx context.Context) (uint64, e
 	return m, nil
 }
 
-func (repo *eventRepo) QueryEventBySignature(ctx context.Context, signs []string) (map[string]domain.Event, error) {
-	var eventsBySign = make(map[string]domain.Event)
-	if len(signs) == 0 {
-		return eventsBySign, nil
-	}
-
-	for _, sign := range signs {
-
-		entity, err := repo.queryEventBySignature(ctx, sign)
+func (repo *eventRepo) LoadMany(ctx context.Context, signs []string) (map[string]domain.Event, error) {
+	var (
+		eventsBySign = make(map[string]domain.Event)
+		seen         = make(map[string]struct{})
+	)
+
+	for _, batch := range chunk(signs, loadBatchSize) {
+		var ms []*models.Event
+		err := repo.db.WithContext(ctx).
+			Where("`err_code` = 0 and `sign` IN ?", batch).
+			Order("`block_number` DESC").
+			Order("`id` DESC").
+			Find(&ms).Error
 		if err != nil {
 			return nil, err
 		}
 
-		if entity == nil {
-			continue
-		}
-
-		eventsBySign[sign] = entity
-	}
-
-	return eventsBySign, nil
-}
+		// ordered by block, the first one of a signature is the latest.
+		for _, m := range ms {
+			if _, existed := seen[m.Sign]; existed {
+				continue
+			}
+			seen[m.Sign] = struct{}{}
 
-func (repo *eventRepo) queryEventBySignature(ctx context.Context, signs string) (domain.Event, error) {
-	var m models.Event
+			entity := acl.ConvertModelToEvent(m)
+			if entity == nil {
+				continue
+			}
 
-	err := repo.db.WithContext(ctx).
-		Where("`err_code` = 0 and `sign` = ?", signs).
-		Order("`block_number` DESC").
-		Take(&m).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil
+			eventsBySign[m.Sign] = entity
 		}
-
-		return nil, err
 	}
 
-	return acl.ConvertModelToEvent(&m), nil
+	return eventsBySign, nil
 }
 
-func (repo *eventRepo) SubscribeEvent(ctx context.Context, startBlock uint64) (*domain.Stream[domain.EventsByBlock], error) {
-
-	stream := domain.NewEventStream[domain.EventsByBlock](100)
-
-	go func() {
-
-		startBlock := startBlock
-		for {
-			blocks, err := repo.LoadEventsByBlocks(ctx, startBlock, 100)
-			if err != nil {
-				stream.SendErr(err)
-				return
-			}
-
-			if len(blocks) == 0 {
-				break
-			}
+func (repo *eventRepo) SubscribeEvent(ctx context.Context, after domain.EventCursor, filter *domain.EventFilter) (*domain.Stream[domain.EventsByBlock], error) {
 
-			for _, m := range blocks {
-				stream.Send(m)
-				startBlock = m.BlockNumber
-			}
+	sub := newSubscription(filter, after)
 
-			if blocks[len(blocks)-1].BlockNumber == startBlock {
-				break
-			}
-		}
-
-		repo.rw.Lock()
-		repo.subscriber[stream.ID()] = stream
-		repo.rw.Unlock()
+	// registered before the catch-up, so that no block published meanwhile is missed.
+	repo.rw.Lock()
+	repo.subscriber[sub.stream.ID()] = sub
+	repo.rw.Unlock()
 
-		select {
-		case <-ctx.Done():
+	go func() {
+		defer func() {
 			repo.rw.Lock()
-			delete(repo.subscriber, stream.ID())
+			delete(repo.subscriber, sub.stream.ID())
 			repo.rw.Unlock()
-			stream.Close()
+		}()
+
+		err := sub.run(ctx, repo.LoadEventsByBlocks)
+		if ctx.Err() != nil {
+			sub.stream.Close()
+			return
 		}
+
+		sub.stream.SendErr(err)
 	}()
 
-	return stream, nil
+	return sub.stream, nil
 }
 
-func (repo *eventRepo) LoadEventsByBlocks(ctx context.Context, startBlock uint64, limit int) ([]*domain.EventsByBlock, error) {
+func (repo *eventRepo) LoadEventsByBlocks(ctx context.Context, after domain.EventCursor, limit int, filter *domain.EventFilter) ([]*domain.EventsByBlock, error) {
 
 	var ms []*models.Event
-	result := repo.db.WithContext(ctx).
-		Table((&models.Event{}).TableName()).
-		Where("`block_number` > ?", startBlock).
+	result := filterEvents(repo.db.WithContext(ctx).Table((&models.Event{}).TableName()), filter).
+		Where("(`block_number` > ? or (`block_number` = ? and `id` > ?))", after.BlockNumber, after.BlockNumber, after.EventID).
 		Limit(limit).
 		Order("`block_number` ASC, `id` ASC").Find(&ms)
 	if err := result.Error; err != nil {
@@ -148,7 +126,7 @@ func (repo *eventRepo) LoadEventsByBlocks(ctx context.Context, startBlock uint64
 
 	lastBlock := ms[len(ms)-1]
 	var ms1 []*models.Event
-	err := repo.db.WithContext(ctx).Table((&models.Event{}).TableName()).
+	err := filterEvents(repo.db.WithContext(ctx).Table((&models.Event{}).TableName()), filter).
 		Where("`block_number` = ? and id > ?", lastBlock.BlockNumber, lastBlock.ID).
 		Order("`id` ASC").Find(&ms1).Error
 	if err != nil {
@@ -157,26 +135,45 @@ func (repo *eventRepo) LoadEventsByBlocks(ctx context.Context, startBlock uint64
 
 	ms = append(ms, ms1...)
 
-	var blocksMap = make(map[uint64]*domain.EventsByBlock)
 	var blocks []*domain.EventsByBlock
 	for _, m := range ms {
-
-		block, existed := blocksMap[m.BlockNumber]
-		if !existed {
-			block = &domain.EventsByBlock{
-				BlockNumber: m.BlockNumber,
-				Events:      nil,
-			}
-			blocksMap[block.BlockNumber] = block
-			blocks = append(blocks, block)
+		if len(blocks) == 0 || blocks[len(blocks)-1].BlockNumber != m.BlockNumber {
+			blocks = append(blocks, &domain.EventsByBlock{BlockNumber: m.BlockNumber})
 		}
 
+		block := blocks[len(blocks)-1]
 		block.Events = append(block.Events, acl.ConvertModelToEvent(m))
+		block.LastEventID = m.ID
 	}
 
 	return blocks, nil
 }
 
+func filterEvents(db *gorm.DB, filter *domain.EventFilter) *gorm.DB {
+	if filter.IsEmpty() {
+		return db
+	}
+
+	if filter.Ticks.Cardinality() > 0 {
+		db = db.Where("`tick` IN ?", filter.Ticks.ToSlice())
+	}
+
+	if filter.Addresses.Cardinality() > 0 {
+		addresses := filter.Addresses.ToSlice()
+		db = db.Where("(`ierc_from` IN ? or `ierc_to` IN ?)", addresses, addresses)
+	}
+
+	if filter.Operates.Cardinality() > 0 {
+		db = db.Where("`operate` IN ?", filter.Operates.ToSlice())
+	}
+
+	if filter.ExcludeFailed {
+		db = db.Where("`err_code` = 0")
+	}
+
+	return db
+}
+
 func (repo *eventRepo) QueryEventsByBlocks(ctx context.Context, startBlock uint64, blockNum int) ([]*domain.EventsByBlock, error) {
 
 	var blockNums []uint64
@@ -231,6 +228,71 @@ func (repo *eventRepo) QueryEventsByBlocks(ctx context.Context, startBlock uint6
 	return blocks, nil
 }
 
+func (repo *eventRepo) QueryEvents(ctx context.Context, query domain.EventQuery) ([]*domain.EventsByBlock, string, error) {
+
+	db := repo.db.WithContext(ctx).
+		Table((&models.Event{}).TableName()).
+		Where("`block_number` > ?", query.StartBlock)
+
+	if query.Tick != "" {
+		db = db.Where("`tick` = ?", query.Tick)
+	}
+
+	if query.Address != "" {
+		db = db.Where("(`ierc_from` = ? or `ierc_to` = ?)", query.Address, query.Address)
+	}
+
+	if query.Operate != "" {
+		db = db.Where("`operate` = ?", query.Operate)
+	}
+
+	if query.ErrCode != nil {
+		db = db.Where("`err_code` = ?", *query.ErrCode)
+	}
+
+	if !query.EventAtFrom.IsZero() {
+		db = db.Where("`event_at` >= ?", query.EventAtFrom)
+	}
+
+	if !query.EventAtTo.IsZero() {
+		db = db.Where("`event_at` < ?", query.EventAtTo)
+	}
+
+	if query.Cursor != "" {
+		cursor, err := domain.ParseEventCursor(query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		db = db.Where("(`block_number` > ? or (`block_number` = ? and `id` > ?))", cursor.BlockNumber, cursor.BlockNumber, cursor.EventID)
+	}
+
+	var ms []*models.Event
+	if err := db.Order("`block_number` ASC, `id` ASC").Limit(query.Size + 1).Find(&ms).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(ms) > query.Size {
+		ms = ms[:query.Size]
+		last := ms[len(ms)-1]
+		next = domain.EventCursor{BlockNumber: last.BlockNumber, EventID: last.ID}.String()
+	}
+
+	var blocks []*domain.EventsByBlock
+	for _, m := range ms {
+		if len(blocks) == 0 || blocks[len(blocks)-1].BlockNumber != m.BlockNumber {
+			blocks = append(blocks, &domain.EventsByBlock{BlockNumber: m.BlockNumber})
+		}
+
+		block := blocks[len(blocks)-1]
+		block.Events = append(block.Events, acl.ConvertModelToEvent(m))
+		block.LastEventID = m.ID
+	}
+
+	return blocks, next, nil
+}
+
 func (repo *eventRepo) QueryEventsByHash(ctx context.Context, hash string) ([]domain.Event, error) {
 
 	var ms []*models.Event
@@ -258,35 +320,69 @@ func (repo *eventRepo) Save(ctx context.Context, event *domain.EventsByBlock) er
 		return nil
 	}
 
-	dbWithTx := rctx.TransactionDBFromContext(ctx)
-	if dbWithTx == nil {
-		panic("missing db instance")
-	}
+	switch rctx.UpdateKindFromContext(ctx) {
+	case rctx.UpdateDB:
+		dbWithTx := rctx.TransactionDBFromContext(ctx)
+		if dbWithTx == nil {
+			panic("missing db instance")
+		}
 
-	var ms []*models.Event
-	for _, entity := range event.Events {
-		ms = append(ms, acl.ConvertEventToModel(entity))
-	}
+		var ms []*models.Event
+		for _, entity := range event.Events {
+			ms = append(ms, acl.ConvertEventToModel(entity))
+		}
 
-	if err := dbWithTx.CreateInBatches(ms, 1000).Error; err != nil {
-		return err
-	}
+		if err := dbWithTx.CreateInBatches(ms, 1000).Error; err != nil {
+			return err
+		}
 
-	return repo.publishEvents(ctx, event)
+		event.LastEventID = ms[len(ms)-1].ID
+		return nil
+
+	case rctx.UpdateCache:
+		// published after commit, subscribers falling behind are refilled from the database.
+		return repo.publishEvents(ctx, event)
+
+	default:
+		return nil
+	}
 }
 
-func (repo *eventRepo) publishEvents(ctx context.Context, event *domain.EventsByBlock) error {
-	if len(repo.subscriber) == 0 {
+func (repo *eventRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+
+	switch rctx.UpdateKindFromContext(ctx) {
+	case rctx.UpdateDB:
+		dbWithTx := rctx.TransactionDBFromContext(ctx)
+		if dbWithTx == nil {
+			panic("missing db instance")
+		}
+
+		return dbWithTx.Where("`block_number` > ?", blockNumber).Delete(&models.Event{}).Error
+
+	case rctx.UpdateCache:
+		var lastEventID int64
+		err := repo.db.WithContext(ctx).
+			Table((&models.Event{}).TableName()).
+			Select("IFNULL(MAX(`id`), 0)").
+			Where("`block_number` <= ?", blockNumber).
+			Scan(&lastEventID).Error
+		if err != nil {
+			return err
+		}
+
+		return repo.publishEvents(ctx, &domain.EventsByBlock{BlockNumber: blockNumber, LastEventID: lastEventID, Rollback: true})
+
+	default:
 		return nil
 	}
+}
 
+func (repo *eventRepo) publishEvents(ctx context.Context, event *domain.EventsByBlock) error {
 	repo.rw.Lock()
 	defer repo.rw.Unlock()
-	for _, stream := range repo.subscriber {
-		select {
-		case stream.Input() <- event:
-		default:
-		}
+
+	for _, sub := range repo.subscriber {
+		sub.publish(event)
 	}
 
 	return nil
@@ -0,0 +1,171 @@
+package mysqlimpl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+)
+
+const (
+	subscriberBufferSize  = 100
+	subscriberLoadSize    = 100
+	slowSubscriberTimeout = time.Minute
+)
+
+type eventsLoader func(ctx context.Context, after domain.EventCursor, limit int, filter *domain.EventFilter) ([]*domain.EventsByBlock, error)
+
+// subscription delivers the events of a subscriber in order. live blocks are queued by publish,
+// when the queue overflows they are dropped and the subscriber is refilled from the database instead.
+type subscription struct {
+	stream *domain.Stream[domain.EventsByBlock]
+	filter *domain.EventFilter
+
+	// position is the cursor of the last block delivered, only accessed by run.
+	position domain.EventCursor
+
+	mu       sync.Mutex
+	notify   chan struct{}
+	pending  []*domain.EventsByBlock
+	overflow bool
+	// rollback is the lowest rollback dropped on overflow, nil if none.
+	rollback *domain.EventsByBlock
+}
+
+func newSubscription(filter *domain.EventFilter, after domain.EventCursor) *subscription {
+	return &subscription{
+		stream:   domain.NewEventStream[domain.EventsByBlock](subscriberBufferSize),
+		filter:   filter,
+		position: after,
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// publish queues a live block, it never blocks the block handler.
+func (sub *subscription) publish(block *domain.EventsByBlock) {
+	block = sub.filter.Apply(block)
+	if block == nil {
+		return
+	}
+
+	sub.mu.Lock()
+	switch {
+	case !sub.overflow && len(sub.pending) < subscriberBufferSize:
+		sub.pending = append(sub.pending, block)
+
+	case !sub.overflow:
+		sub.overflow = true
+		for _, item := range sub.pending {
+			sub.dropped(item)
+		}
+		sub.pending = nil
+		sub.dropped(block)
+
+	default:
+		sub.dropped(block)
+	}
+	sub.mu.Unlock()
+
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (sub *subscription) dropped(block *domain.EventsByBlock) {
+	if block.Rollback && (sub.rollback == nil || block.BlockNumber < sub.rollback.BlockNumber) {
+		sub.rollback = block
+	}
+}
+
+func (sub *subscription) take() (pending []*domain.EventsByBlock, overflow bool, rollback *domain.EventsByBlock) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	pending, overflow, rollback = sub.pending, sub.overflow, sub.rollback
+	sub.pending, sub.overflow, sub.rollback = nil, false, nil
+	return pending, overflow, rollback
+}
+
+// run catches up from the database, then delivers live blocks until ctx is done or the subscriber is too slow.
+func (sub *subscription) run(ctx context.Context, load eventsLoader) error {
+	for {
+		if err := sub.refill(ctx, load); err != nil {
+			return err
+		}
+
+		if err := sub.follow(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (sub *subscription) refill(ctx context.Context, load eventsLoader) error {
+	for {
+		blocks, err := load(ctx, sub.position, subscriberLoadSize, sub.filter)
+		if err != nil {
+			return err
+		}
+
+		if len(blocks) == 0 {
+			return nil
+		}
+
+		for _, block := range blocks {
+			if err := sub.deliver(ctx, block); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// follow delivers the queued live blocks, it returns nil when the queue overflowed and a refill is needed.
+func (sub *subscription) follow(ctx context.Context) error {
+	for {
+		pending, overflow, rollback := sub.take()
+
+		if overflow {
+			if rollback != nil && rollback.BlockNumber < sub.position.BlockNumber {
+				return sub.deliver(ctx, rollback)
+			}
+			return nil
+		}
+
+		for _, block := range pending {
+			// blocks published meanwhile the catch-up may have been loaded already, loaded blocks are complete.
+			if !block.Rollback && block.BlockNumber <= sub.position.BlockNumber {
+				continue
+			}
+
+			if err := sub.deliver(ctx, block); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-sub.notify:
+		}
+	}
+}
+
+func (sub *subscription) deliver(ctx context.Context, block *domain.EventsByBlock) error {
+	timer := time.NewTimer(slowSubscriberTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return domain.ErrSlowSubscriber
+	case sub.stream.Input() <- block:
+	}
+
+	if !block.Rollback || block.BlockNumber < sub.position.BlockNumber {
+		sub.position = block.Cursor()
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package mysqlimpl
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+type committedBlocks struct {
+	mu     sync.Mutex
+	blocks []*domain.EventsByBlock
+}
+
+func (c *committedBlocks) commit(blockNumber uint64) *domain.EventsByBlock {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	block := &domain.EventsByBlock{
+		BlockNumber: blockNumber,
+		Events:      []domain.Event{&domain.IERC20MintedEvent{BlockNumber: blockNumber}},
+		LastEventID: int64(len(c.blocks) + 1),
+	}
+	c.blocks = append(c.blocks, block)
+	return block
+}
+
+func (c *committedBlocks) load(_ context.Context, after domain.EventCursor, limit int, _ *domain.EventFilter) ([]*domain.EventsByBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []*domain.EventsByBlock
+	for _, block := range c.blocks {
+		if after.Less(block.Cursor()) && len(result) < limit {
+			result = append(result, block)
+		}
+	}
+	return result, nil
+}
+
+func TestSubscriptionRefillsOnOverflow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		db  committedBlocks
+		sub = newSubscription(nil, domain.BlockCursor(0))
+	)
+
+	for i := uint64(1); i <= 5; i++ {
+		db.commit(i)
+	}
+
+	go func() { _ = sub.run(ctx, db.load) }()
+
+	// the consumer does not read while far more blocks than buffered are published.
+	for i := uint64(6); i <= 500; i++ {
+		sub.publish(db.commit(i))
+	}
+
+	for want := uint64(1); want <= 500; want++ {
+		select {
+		case block := <-sub.stream.Next():
+			assert.Equal(t, want, block.BlockNumber)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("block %d not delivered", want)
+		}
+	}
+
+	sub.publish(&domain.EventsByBlock{BlockNumber: 400, LastEventID: 400, Rollback: true})
+	block := <-sub.stream.Next()
+	assert.True(t, block.Rollback)
+	assert.Equal(t, domain.EventCursor{BlockNumber: 400, EventID: 400}, block.Cursor())
+
+	sub.publish(&domain.EventsByBlock{BlockNumber: 401, LastEventID: 501})
+	assert.Equal(t, uint64(401), (<-sub.stream.Next()).BlockNumber)
+}
+
+func TestSubscriptionResumesFromCursor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var db committedBlocks
+	for i := uint64(1); i <= 3; i++ {
+		db.commit(i)
+	}
+
+	// resumed right after the last event of block 2, block 2 is not sent again.
+	sub := newSubscription(nil, domain.EventCursor{BlockNumber: 2, EventID: 2})
+	go func() { _ = sub.run(ctx, db.load) }()
+
+	block := <-sub.stream.Next()
+	assert.Equal(t, uint64(3), block.BlockNumber)
+
+	cursor, err := domain.ParseEventCursor(block.Cursor().String())
+	assert.NoError(t, err)
+	assert.Equal(t, block.Cursor(), cursor)
+}
@@ -0,0 +1,201 @@
+package mysqlimpl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+)
+
+const journalPruneBatchSize = 10000
+
+type journalRepo struct {
+	db *gorm.DB
+}
+
+func NewJournalRepository(db *gorm.DB) domain.JournalRepository {
+	return &journalRepo{db: db}
+}
+
+func (repo *journalRepo) GetFirstJournalBlock(ctx context.Context) (uint64, error) {
+
+	var m uint64
+	err := repo.db.WithContext(ctx).
+		Table((&models.StateJournal{}).TableName()).
+		Select("block_number").
+		Order("`block_number` ASC").
+		Take(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return m, nil
+}
+
+func (repo *journalRepo) PruneJournals(ctx context.Context, blockNumber uint64) error {
+	for {
+		result := repo.db.WithContext(ctx).
+			Where("`block_number` <= ?", blockNumber).
+			Limit(journalPruneBatchSize).
+			Delete(&models.StateJournal{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected < journalPruneBatchSize {
+			return nil
+		}
+	}
+}
+
+// journalSchema describes how the rows of a state table are identified.
+type journalSchema[T any] struct {
+	table   string
+	columns []string
+	key     func(m *T) []any
+}
+
+func (s *journalSchema[T]) keyString(m *T) string {
+	return fmt.Sprint(s.key(m)...)
+}
+
+func (s *journalSchema[T]) keyBytes(m *T) []byte {
+	var (
+		values = s.key(m)
+		key    = make(map[string]any, len(s.columns))
+	)
+
+	for idx, column := range s.columns {
+		key[column] = values[idx]
+	}
+
+	data, _ := json.Marshal(key)
+	return data
+}
+
+// saveJournals records the current rows of ms before they are overwritten by block blockNumber.
+func saveJournals[T any](db *gorm.DB, schema *journalSchema[T], blockNumber uint64, ms []*T) error {
+	if len(ms) == 0 {
+		return nil
+	}
+
+	existedMap, err := loadRows(db, schema, ms)
+	if err != nil {
+		return err
+	}
+
+	return createJournals(db, schema, blockNumber, ms, existedMap)
+}
+
+// loadRows returns the current rows of ms by key string.
+func loadRows[T any](db *gorm.DB, schema *journalSchema[T], ms []*T) (map[string]*T, error) {
+	var (
+		keys    = make([][]any, 0, len(ms))
+		columns = make([]string, 0, len(schema.columns))
+	)
+
+	for _, m := range ms {
+		keys = append(keys, schema.key(m))
+	}
+
+	for _, column := range schema.columns {
+		columns = append(columns, fmt.Sprintf("`%s`", column))
+	}
+
+	var existed []*T
+	err := db.Table(schema.table).
+		Where(fmt.Sprintf("(%s) IN ?", strings.Join(columns, ",")), keys).
+		Find(&existed).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var existedMap = make(map[string]*T, len(existed))
+	for _, m := range existed {
+		existedMap[schema.keyString(m)] = m
+	}
+
+	return existedMap, nil
+}
+
+func createJournals[T any](db *gorm.DB, schema *journalSchema[T], blockNumber uint64, ms []*T, existedMap map[string]*T) error {
+	var journals = make([]*models.StateJournal, 0, len(ms))
+	for _, m := range ms {
+		journal := &models.StateJournal{
+			BlockNumber: blockNumber,
+			Kind:        schema.table,
+			Key:         schema.keyBytes(m),
+		}
+
+		if before, ok := existedMap[schema.keyString(m)]; ok {
+			data, err := json.Marshal(before)
+			if err != nil {
+				return err
+			}
+
+			journal.Data = data
+		}
+
+		journals = append(journals, journal)
+	}
+
+	return db.CreateInBatches(journals, 1000).Error
+}
+
+// rollbackJournals restores the rows of the table to the state of block blockNumber.
+func rollbackJournals[T any](db *gorm.DB, schema *journalSchema[T], blockNumber uint64) error {
+
+	var journals []*models.StateJournal
+	err := db.Table((&models.StateJournal{}).TableName()).
+		Where("`kind` = ? and `block_number` > ?", schema.table, blockNumber).
+		Order("`block_number` ASC, `id` ASC").
+		Find(&journals).Error
+	if err != nil {
+		return err
+	}
+
+	var restored = make(map[string]struct{}, len(journals))
+	for _, journal := range journals {
+		if _, existed := restored[string(journal.Key)]; existed {
+			continue
+		}
+		restored[string(journal.Key)] = struct{}{}
+
+		var key map[string]any
+		decoder := json.NewDecoder(bytes.NewReader(journal.Key))
+		decoder.UseNumber()
+		if err := decoder.Decode(&key); err != nil {
+			return err
+		}
+
+		if err := db.Table(schema.table).Where(key).Delete(new(T)).Error; err != nil {
+			return err
+		}
+
+		if len(journal.Data) == 0 {
+			continue
+		}
+
+		var m T
+		if err := json.Unmarshal(journal.Data, &m); err != nil {
+			return err
+		}
+
+		if err := db.Table(schema.table).Create(&m).Error; err != nil {
+			return err
+		}
+	}
+
+	return db.Where("`kind` = ? and `block_number` > ?", schema.table, blockNumber).
+		Delete(&models.StateJournal{}).Error
+}
@@ -0,0 +1,200 @@
+package mysqlimpl
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/market"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	rctx "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/context"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type listingRepo struct {
+	db *gorm.DB
+}
+
+func NewListingRepository(db *gorm.DB) market.ListingRepository {
+	return &listingRepo{db: db}
+}
+
+func (repo *listingRepo) Save(ctx context.Context, updates *market.ListingUpdates) error {
+
+	if updates.IsEmpty() || rctx.UpdateKindFromContext(ctx) != rctx.UpdateDB {
+		return nil
+	}
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	return saveListings(dbWithTx, updates)
+}
+
+func saveListings(db *gorm.DB, updates *market.ListingUpdates) error {
+	for _, c := range updates.Closes {
+		err := db.Model(&models.MarketListing{}).
+			Where("sign = ? and status = ?", c.Sign, market.ListingListed).
+			Updates(map[string]any{
+				"status":         c.Status,
+				"buyer":          c.Buyer,
+				"closed_tx_hash": c.TxHash,
+				"closed_block":   c.BlockNumber,
+			}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(updates.Listings) == 0 {
+		return nil
+	}
+
+	var ms = make([]*models.MarketListing, 0, len(updates.Listings))
+	for _, listing := range updates.Listings {
+		ms = append(ms, acl.ConvertListingEntityToModel(listing))
+	}
+
+	return db.CreateInBatches(ms, 1000).Error
+}
+
+// Rollback deletes the listings above the block and opens again the ones closed above it.
+func (repo *listingRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+
+	if rctx.UpdateKindFromContext(ctx) != rctx.UpdateDB {
+		return nil
+	}
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	if err := dbWithTx.Where("listed_block > ?", blockNumber).Delete(&models.MarketListing{}).Error; err != nil {
+		return err
+	}
+
+	return dbWithTx.Model(&models.MarketListing{}).
+		Where("closed_block > ?", blockNumber).
+		Updates(map[string]any{
+			"status":         market.ListingListed,
+			"buyer":          "",
+			"closed_tx_hash": "",
+			"closed_block":   0,
+		}).Error
+}
+
+func (repo *listingRepo) QueryListing(ctx context.Context, sign string) (*market.Listing, error) {
+	var m models.MarketListing
+	if err := repo.db.WithContext(ctx).Where("sign = ?", sign).Order("id DESC").Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return acl.ConvertListingModelToEntity(&m), nil
+}
+
+func (repo *listingRepo) QueryListings(ctx context.Context, opts market.ListingQueryOptions) ([]*market.Listing, string, error) {
+	db := repo.db.WithContext(ctx)
+	if opts.Tick != "" {
+		db = db.Where("tick = ?", opts.Tick)
+	}
+
+	if opts.Seller != "" {
+		db = db.Where("seller = ?", opts.Seller)
+	}
+
+	if opts.Status != 0 {
+		db = db.Where("status = ?", opts.Status)
+	}
+
+	var (
+		ms   []*models.MarketListing
+		next string
+		err  error
+	)
+	if opts.OrderByPrice {
+		ms, next, err = queryListingsByPrice(db, opts.Cursor, opts.Size)
+	} else {
+		ms, next, err = queryPageByID(db, opts.Cursor, opts.Size, func(m *models.MarketListing) int64 { return m.ID })
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	var entities = make([]*market.Listing, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertListingModelToEntity(m))
+	}
+
+	return entities, next, nil
+}
+
+// queryListingsByPrice pages listings by price asc, ties are broken by id.
+func queryListingsByPrice(db *gorm.DB, cursor string, size int) ([]*models.MarketListing, string, error) {
+	db = db.Order("price ASC").Order("id ASC")
+	if cursor != "" {
+		values, err := utils.DecodeCursor(cursor, 2)
+		if err != nil {
+			return nil, "", err
+		}
+
+		price, err := decimal.NewFromString(values[0])
+		if err != nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+
+		id, err := strconv.ParseInt(values[1], 10, 64)
+		if err != nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+
+		db = db.Where("price > ? or (price = ? and id > ?)", price, price, id)
+	}
+
+	var ms []*models.MarketListing
+	if err := db.Limit(size + 1).Find(&ms).Error; err != nil {
+		return nil, "", err
+	}
+
+	if len(ms) <= size {
+		return ms, "", nil
+	}
+
+	ms = ms[:size]
+	last := ms[size-1]
+	return ms, utils.EncodeCursor(last.Price.String(), strconv.FormatInt(last.ID, 10)), nil
+}
+
+// SeedListings replays the trade events indexed before the listings existed.
+func SeedListings(db *gorm.DB) error {
+	if err := db.Where("1 = 1").Delete(&models.MarketListing{}).Error; err != nil {
+		return err
+	}
+
+	var ms []*models.Event
+	return db.
+		Where("operate IN ? and err_code = ?", []string{
+			string(protocol.OpFreezeSell),
+			string(protocol.OpProxyTransfer),
+			string(protocol.OpUnfreezeSell),
+		}, 0).
+		FindInBatches(&ms, 1000, func(tx *gorm.DB, batch int) error {
+			var events = make([]domain.Event, 0, len(ms))
+			for _, m := range ms {
+				events = append(events, acl.ConvertModelToEvent(m))
+			}
+
+			return saveListings(db, market.NewListingUpdates(events))
+		}).Error
+}
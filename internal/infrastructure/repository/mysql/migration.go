@@ -0,0 +1,54 @@
+package mysqlimpl
+
+import (
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+)
+
+// Migration seeds a projection from the indexed state. a seed clears the projection first,
+// it runs again until its marker is written.
+type Migration struct {
+	Name string
+	Seed func(tx *gorm.DB) error
+}
+
+// Migrations are run in order.
+var Migrations = []Migration{
+	{Name: "seed_balance_changes", Seed: SeedBalanceChanges},
+	{Name: "seed_market_listings", Seed: SeedListings},
+	{Name: "seed_market_tick_stats", Seed: SeedTickStats},
+	{Name: "seed_staking_balance_changes", Seed: SeedStakingBalanceChanges},
+}
+
+// PendingMigrations returns the migrations without a marker.
+func PendingMigrations(db *gorm.DB) ([]Migration, error) {
+	var done []string
+	if err := db.Model(&models.Migration{}).Pluck("name", &done).Error; err != nil {
+		return nil, err
+	}
+
+	var marked = make(map[string]struct{}, len(done))
+	for _, name := range done {
+		marked[name] = struct{}{}
+	}
+
+	var pending []Migration
+	for _, m := range Migrations {
+		if _, ok := marked[m.Name]; !ok {
+			pending = append(pending, m)
+		}
+	}
+
+	return pending, nil
+}
+
+// RunMigration seeds the projection and writes the marker in one transaction.
+func RunMigration(db *gorm.DB, m Migration) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := m.Seed(tx); err != nil {
+			return err
+		}
+
+		return tx.Create(&models.Migration{Name: m.Name}).Error
+	})
+}
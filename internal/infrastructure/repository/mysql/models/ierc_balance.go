@@ -21,3 +21,24 @@ type IERC20Balance struct {
 func (b *IERC20Balance) TableName() string {
 	return "ierc_balances"
 }
+
+// IERC20BalanceChange is the ledger of the balances, a row per balance and block that changed it.
+// a snapshot row records a balance existing when the ledger was created, its history before is unknown.
+type IERC20BalanceChange struct {
+	ID             int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Address        string          `gorm:"<-:create;column:address;type:varchar(42);uniqueIndex:uni_address_tick_block,priority:1;index:idx_address_block,priority:1;not null;default:''"`
+	Tick           string          `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:uni_address_tick_block,priority:2;index:idx_tick_block,priority:1;not null;default:''"`
+	BlockNumber    uint64          `gorm:"<-:create;column:block_number;type:bigint;uniqueIndex:uni_address_tick_block,priority:3;index:idx_address_block,priority:2;index:idx_tick_block,priority:2;index:idx_block_number"`
+	Available      decimal.Decimal `gorm:"column:available;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Freeze         decimal.Decimal `gorm:"column:freeze;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Minted         decimal.Decimal `gorm:"column:minted;type:decimal(50,18);not null;default:0.000000000000000000"`
+	AvailableDelta decimal.Decimal `gorm:"column:available_delta;type:decimal(50,18);not null;default:0.000000000000000000"`
+	FreezeDelta    decimal.Decimal `gorm:"column:freeze_delta;type:decimal(50,18);not null;default:0.000000000000000000"`
+	MintedDelta    decimal.Decimal `gorm:"column:minted_delta;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Snapshot       bool            `gorm:"column:snapshot;not null;default:false"`
+	CreatedAt      time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (b *IERC20BalanceChange) TableName() string {
+	return "ierc_balance_changes"
+}
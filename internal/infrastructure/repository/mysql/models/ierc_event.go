@@ -14,8 +14,8 @@ type Event struct {
 	Tick        string          `gorm:"<-:create;column:tick;type:varchar(64);index:idx_tick;not null;default:''"`
 	ETHFrom     string          `gorm:"<-:create;column:eth_from;type:varchar(42);index:idx_ierc_from;not null"`
 	ETHTo       string          `gorm:"<-:create;column:eth_to;type:varchar(42);index:idx_ierc_to;not null"`
-	IERCFrom    string          `gorm:"<-:create;column:ierc_from;type:varchar(42);index:idx_ierc_from;not null"`
-	IERCTo      string          `gorm:"<-:create;column:ierc_to;type:varchar(42);index:idx_ierc_to;not null"`
+	IERCFrom    string          `gorm:"<-:create;column:ierc_from;type:varchar(42);index:idx_ierc_from;index:idx_from;not null"`
+	IERCTo      string          `gorm:"<-:create;column:ierc_to;type:varchar(42);index:idx_ierc_to;index:idx_to;not null"`
 	Amount      decimal.Decimal `gorm:"<-:create;column:amount;type:decimal(50,18);not null;default:0.000000000000000000"`
 	Sign        string          `gorm:"<-:create;column:sign;type:varchar(256);index:idx_sign;not null;default:''"`
 	EventKind   uint8           `gorm:"<-:create;column:event_kind;type:tinyint;not null;default:0"`
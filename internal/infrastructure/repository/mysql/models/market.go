@@ -0,0 +1,87 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type MarketListing struct {
+	ID           int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	TxHash       string          `gorm:"<-:create;column:tx_hash;type:varchar(66);uniqueIndex:uni_listing,priority:1;not null"`
+	Position     int             `gorm:"<-:create;column:position;type:int;uniqueIndex:uni_listing,priority:2;not null"`
+	Sign         string          `gorm:"<-:create;column:sign;type:varchar(256);index:idx_sign;not null"`
+	Protocol     string          `gorm:"<-:create;column:protocol;type:varchar(20);not null;default:''"`
+	Tick         string          `gorm:"<-:create;column:tick;type:varchar(64);index:idx_tick_status_price,priority:1;not null"`
+	Seller       string          `gorm:"<-:create;column:seller;type:varchar(42);index:idx_seller;not null"`
+	Platform     string          `gorm:"<-:create;column:platform;type:varchar(42);not null"`
+	Amount       decimal.Decimal `gorm:"<-:create;column:amount;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Value        decimal.Decimal `gorm:"<-:create;column:value;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Price        decimal.Decimal `gorm:"<-:create;column:price;type:decimal(50,18);index:idx_tick_status_price,priority:3;not null;default:0.000000000000000000"`
+	Status       uint8           `gorm:"column:status;type:tinyint;index:idx_tick_status_price,priority:2;not null;default:0"`
+	ListedBlock  uint64          `gorm:"<-:create;column:listed_block;type:bigint;index:idx_listed_block"`
+	Buyer        string          `gorm:"column:buyer;type:varchar(42);not null;default:''"`
+	ClosedTxHash string          `gorm:"column:closed_tx_hash;type:varchar(66);not null;default:''"`
+	ClosedBlock  uint64          `gorm:"column:closed_block;type:bigint;index:idx_closed_block;not null;default:0"`
+	CreatedAt    time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt    time.Time       `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (t *MarketListing) TableName() string {
+	return "market_listings"
+}
+
+// MarketTickStats is the statistics of a tick at a block changing it. a snapshot row records
+// the statistics existing when the table was created, without the activity of its block.
+type MarketTickStats struct {
+	ID             int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Tick           string          `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:uni_tick_block,priority:1;not null"`
+	BlockNumber    uint64          `gorm:"<-:create;column:block_number;type:bigint;uniqueIndex:uni_tick_block,priority:2;index:idx_block_number"`
+	Day            time.Time       `gorm:"<-:create;column:day;type:date"`
+	HolderDelta    int64           `gorm:"column:holder_delta;type:bigint;not null;default:0"`
+	Transfers      int64           `gorm:"column:transfers;type:bigint;not null;default:0"`
+	Mints          int64           `gorm:"column:mints;type:bigint;not null;default:0"`
+	Minted         decimal.Decimal `gorm:"column:minted;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Trades         int64           `gorm:"column:trades;type:bigint;not null;default:0"`
+	Volume         decimal.Decimal `gorm:"column:volume;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Holders        int64           `gorm:"column:holders;type:bigint;not null;default:0"`
+	TotalTransfers int64           `gorm:"column:total_transfers;type:bigint;not null;default:0"`
+	TotalMints     int64           `gorm:"column:total_mints;type:bigint;not null;default:0"`
+	TotalMinted    decimal.Decimal `gorm:"column:total_minted;type:decimal(50,18);not null;default:0.000000000000000000"`
+	TotalTrades    int64           `gorm:"column:total_trades;type:bigint;not null;default:0"`
+	TotalVolume    decimal.Decimal `gorm:"column:total_volume;type:decimal(50,18);not null;default:0.000000000000000000"`
+	LastPrice      decimal.Decimal `gorm:"column:last_price;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Snapshot       bool            `gorm:"column:snapshot;not null;default:false"`
+	CreatedAt      time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (t *MarketTickStats) TableName() string {
+	return "market_tick_stats"
+}
+
+// MarketTickDailyStats is the statistics of a tick over a UTC day, rolled up from MarketTickStats.
+type MarketTickDailyStats struct {
+	ID             int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Tick           string          `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:uni_tick_day,priority:1;index:idx_tick_block,priority:1;not null"`
+	Day            time.Time       `gorm:"<-:create;column:day;type:date;uniqueIndex:uni_tick_day,priority:2"`
+	BlockNumber    uint64          `gorm:"column:block_number;type:bigint;index:idx_tick_block,priority:2;index:idx_block_number"`
+	HolderDelta    int64           `gorm:"column:holder_delta;type:bigint;not null;default:0"`
+	Transfers      int64           `gorm:"column:transfers;type:bigint;not null;default:0"`
+	Mints          int64           `gorm:"column:mints;type:bigint;not null;default:0"`
+	Minted         decimal.Decimal `gorm:"column:minted;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Trades         int64           `gorm:"column:trades;type:bigint;not null;default:0"`
+	Volume         decimal.Decimal `gorm:"column:volume;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Holders        int64           `gorm:"column:holders;type:bigint;not null;default:0"`
+	TotalTransfers int64           `gorm:"column:total_transfers;type:bigint;not null;default:0"`
+	TotalMints     int64           `gorm:"column:total_mints;type:bigint;not null;default:0"`
+	TotalMinted    decimal.Decimal `gorm:"column:total_minted;type:decimal(50,18);not null;default:0.000000000000000000"`
+	TotalTrades    int64           `gorm:"column:total_trades;type:bigint;not null;default:0"`
+	TotalVolume    decimal.Decimal `gorm:"column:total_volume;type:decimal(50,18);not null;default:0.000000000000000000"`
+	LastPrice      decimal.Decimal `gorm:"column:last_price;type:decimal(50,18);not null;default:0.000000000000000000"`
+	CreatedAt      time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt      time.Time       `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (t *MarketTickDailyStats) TableName() string {
+	return "market_tick_daily_stats"
+}
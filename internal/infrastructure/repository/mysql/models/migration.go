@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+)
+
+// Migration marks a projection seeded over an indexed database, it is written in the transaction of the seed.
+type Migration struct {
+	Name      string    `gorm:"<-:create;column:name;type:varchar(64);primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (m *Migration) TableName() string {
+	return "schema_migrations"
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+)
+
+type OutboxMessage struct {
+	ID          int64     `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Key         string    `gorm:"<-:create;column:msg_key;type:varchar(64);not null;default:''"`
+	BlockNumber uint64    `gorm:"<-:create;column:block_number;type:bigint"`
+	Payload     []byte    `gorm:"<-:create;column:payload;type:json"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime:milli;index:idx_created_at"`
+}
+
+func (t *OutboxMessage) TableName() string {
+	return "outbox_messages"
+}
+
+// OutboxOffset is the id of the last message relayed to the broker, a single row.
+type OutboxOffset struct {
+	ID        int64     `gorm:"column:id;primaryKey"`
+	Offset    int64     `gorm:"column:msg_offset;type:bigint"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (t *OutboxOffset) TableName() string {
+	return "outbox_offsets"
+}
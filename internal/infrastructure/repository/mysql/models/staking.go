@@ -26,7 +26,7 @@ type StakingPosition struct {
 	ID               int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
 	Pool             string          `gorm:"<-:create;column:pool;type:varchar(42);uniqueIndex:uni_pool_staker,priority:1;not null"`
 	PoolID           uint64          `gorm:"<-:create;column:pool_id;type:bigint;uniqueIndex:uni_pool_staker,priority:2"`
-	Staker           string          `gorm:"<-:create;column:staker;type:varchar(42);uniqueIndex:uni_pool_staker,priority:3;not null"`
+	Staker           string          `gorm:"<-:create;column:staker;type:varchar(42);uniqueIndex:uni_pool_staker,priority:3;index:idx_staker;not null"`
 	AccRewards       decimal.Decimal `gorm:"column:acc_rewards;type:decimal(50,18);not null;default:0.000000000000000000"`
 	Debt             decimal.Decimal `gorm:"column:debt;type:decimal(50,18);not null;default:0.000000000000000000"`
 	RewardsPerBlock  decimal.Decimal `gorm:"column:rewards_per_block;type:decimal(50,18);not null;default:0.000000000000000000"`
@@ -56,3 +56,21 @@ type StakingBalance struct {
 func (t *StakingBalance) TableName() string {
 	return "staking_balances"
 }
+
+// StakingBalanceChange is the ledger of the staked balances, a row per balance and block that changed it.
+// a snapshot row records a balance existing when the ledger was created, its history before is unknown.
+type StakingBalanceChange struct {
+	ID          int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Staker      string          `gorm:"<-:create;column:staker;type:varchar(42);uniqueIndex:uni_staker_pool_tick_block,priority:1;not null"`
+	Pool        string          `gorm:"<-:create;column:pool;type:varchar(42);uniqueIndex:uni_staker_pool_tick_block,priority:2;not null"`
+	PoolID      uint64          `gorm:"<-:create;column:pool_id;type:bigint;uniqueIndex:uni_staker_pool_tick_block,priority:3"`
+	Tick        string          `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:uni_staker_pool_tick_block,priority:4;index:idx_tick_block,priority:1;not null;default:''"`
+	BlockNumber uint64          `gorm:"<-:create;column:block_number;type:bigint;uniqueIndex:uni_staker_pool_tick_block,priority:5;index:idx_tick_block,priority:2;index:idx_block_number"`
+	Amount      decimal.Decimal `gorm:"column:amount;type:decimal(50,18);not null;default:0.000000000000000000"`
+	Snapshot    bool            `gorm:"column:snapshot;not null;default:false"`
+	CreatedAt   time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (t *StakingBalanceChange) TableName() string {
+	return "staking_balance_changes"
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// StateJournal keeps the image of a state row before it was modified by a block,
+// it is used to restore the state when the block is rolled back.
+type StateJournal struct {
+	ID          int64     `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	BlockNumber uint64    `gorm:"<-:create;column:block_number;type:bigint;index:idx_block_number"`
+	Kind        string    `gorm:"<-:create;column:kind;type:varchar(32);not null;default:''"`
+	Key         []byte    `gorm:"<-:create;column:row_key;type:json"`
+	Data        []byte    `gorm:"<-:create;column:data;type:json"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (j *StateJournal) TableName() string {
+	return "state_journals"
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+)
+
+type WebhookEndpoint struct {
+	ID        int64     `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	URL       string    `gorm:"<-:create;column:url;type:varchar(512);not null"`
+	Secret    string    `gorm:"<-:create;column:secret;type:varchar(128);not null"`
+	Filter    []byte    `gorm:"<-:create;column:filter;type:json"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (t *WebhookEndpoint) TableName() string {
+	return "webhook_endpoints"
+}
+
+// WebhookDelivery is the outbox of webhook payloads.
+type WebhookDelivery struct {
+	ID            int64     `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	EndpointID    int64     `gorm:"<-:create;column:endpoint_id;type:bigint;index:idx_endpoint_id;not null"`
+	BlockNumber   uint64    `gorm:"<-:create;column:block_number;type:bigint"`
+	Payload       []byte    `gorm:"<-:create;column:payload;type:json"`
+	Status        uint8     `gorm:"column:status;type:tinyint;index:idx_status_next_attempt,priority:1;not null;default:0"`
+	Attempts      int       `gorm:"column:attempts;type:int;not null;default:0"`
+	NextAttemptAt time.Time `gorm:"column:next_attempt_at;index:idx_status_next_attempt,priority:2"`
+	LastError     string    `gorm:"column:last_error;type:varchar(255);not null;default:''"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime:milli;index:idx_updated_at"`
+}
+
+func (t *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookCursor is the position of the last block dispatched to the outbox, a single row.
+type WebhookCursor struct {
+	ID          int64     `gorm:"column:id;primaryKey"`
+	BlockNumber uint64    `gorm:"column:block_number;type:bigint"`
+	EventID     int64     `gorm:"column:event_id;type:bigint"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (t *WebhookCursor) TableName() string {
+	return "webhook_cursors"
+}
@@ -0,0 +1,141 @@
+package mysqlimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
+	rctx "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/context"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const outboxOffsetID = 1
+
+type outboxRepo struct {
+	db      *gorm.DB
+	enabled bool
+}
+
+// NewOutboxRepository returns the outbox, nothing is written when it is disabled.
+func NewOutboxRepository(db *gorm.DB, enabled bool) outbox.Repository {
+	return &outboxRepo{db: db, enabled: enabled}
+}
+
+func (repo *outboxRepo) Save(ctx context.Context, block *domain.EventsByBlock) error {
+
+	if !repo.enabled || len(block.Events) == 0 || rctx.UpdateKindFromContext(ctx) != rctx.UpdateDB {
+		return nil
+	}
+
+	messages, err := outbox.NewMessages(block)
+	if err != nil {
+		return err
+	}
+
+	return repo.create(ctx, messages)
+}
+
+func (repo *outboxRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+
+	if !repo.enabled || rctx.UpdateKindFromContext(ctx) != rctx.UpdateDB {
+		return nil
+	}
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	var ticks []string
+	err := dbWithTx.
+		Table((&models.Event{}).TableName()).
+		Distinct("`tick`").
+		Where("`block_number` > ?", blockNumber).
+		Order("`tick` ASC").
+		Pluck("tick", &ticks).Error
+	if err != nil {
+		return err
+	}
+
+	messages, err := outbox.NewRollbackMessages(blockNumber, ticks)
+	if err != nil {
+		return err
+	}
+
+	return repo.create(ctx, messages)
+}
+
+func (repo *outboxRepo) create(ctx context.Context, messages []*outbox.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	var ms = make([]*models.OutboxMessage, 0, len(messages))
+	for _, message := range messages {
+		ms = append(ms, acl.ConvertOutboxMessageToModel(message))
+	}
+
+	if err := dbWithTx.CreateInBatches(ms, 1000).Error; err != nil {
+		return err
+	}
+
+	for i, m := range ms {
+		messages[i].ID = m.ID
+	}
+
+	return nil
+}
+
+func (repo *outboxRepo) GetOffset(ctx context.Context) (int64, error) {
+	var m models.OutboxOffset
+	err := repo.db.WithContext(ctx).Where("`id` = ?", outboxOffsetID).Take(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return m.Offset, nil
+}
+
+func (repo *outboxRepo) Load(ctx context.Context, offset int64, limit int) ([]*outbox.Message, error) {
+	var ms []*models.OutboxMessage
+	err := repo.db.WithContext(ctx).
+		Where("`id` > ?", offset).
+		Order("`id` ASC").
+		Limit(limit).
+		Find(&ms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var messages = make([]*outbox.Message, 0, len(ms))
+	for _, m := range ms {
+		messages = append(messages, acl.ConvertOutboxMessageModelToEntity(m))
+	}
+
+	return messages, nil
+}
+
+func (repo *outboxRepo) Commit(ctx context.Context, offset int64) error {
+	m := &models.OutboxOffset{ID: outboxOffsetID, Offset: offset}
+	return repo.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(m).Error
+}
+
+func (repo *outboxRepo) Purge(ctx context.Context, offset int64, before time.Time) error {
+	return repo.db.WithContext(ctx).
+		Where("`id` <= ? and `created_at` < ?", offset, before).
+		Delete(&models.OutboxMessage{}).Error
+}
@@ -2,6 +2,8 @@ package mysqlimpl
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
 	rctx "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/context"
@@ -11,6 +13,26 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+var (
+	stakingPoolJournalSchema = &journalSchema[models.StakingPool]{
+		table:   (&models.StakingPool{}).TableName(),
+		columns: []string{`pool`, `pool_id`},
+		key:     func(m *models.StakingPool) []any { return []any{m.Pool, m.PoolID} },
+	}
+
+	stakingPositionJournalSchema = &journalSchema[models.StakingPosition]{
+		table:   (&models.StakingPosition{}).TableName(),
+		columns: []string{`pool`, `pool_id`, `staker`},
+		key:     func(m *models.StakingPosition) []any { return []any{m.Pool, m.PoolID, m.Staker} },
+	}
+
+	stakingBalanceJournalSchema = &journalSchema[models.StakingBalance]{
+		table:   (&models.StakingBalance{}).TableName(),
+		columns: []string{`staker`, `pool`, `pool_id`, `tick`},
+		key:     func(m *models.StakingBalance) []any { return []any{m.Staker, m.Pool, m.PoolID, m.Tick} },
+	}
+)
+
 type stakingRepo struct {
 	db *gorm.DB
 }
@@ -126,6 +148,22 @@ func (repo *stakingRepo) Save(ctx context.Context, blockNumber uint64, roots ...
 		}
 	}
 
+	if err := saveJournals(db.WithContext(ctx), stakingPoolJournalSchema, blockNumber, pools); err != nil {
+		return err
+	}
+
+	if err := saveJournals(db.WithContext(ctx), stakingBalanceJournalSchema, blockNumber, balances); err != nil {
+		return err
+	}
+
+	if err := saveJournals(db.WithContext(ctx), stakingPositionJournalSchema, blockNumber, positions); err != nil {
+		return err
+	}
+
+	if err := saveStakingBalanceChanges(db.WithContext(ctx), blockNumber, balances); err != nil {
+		return err
+	}
+
 	if len(pools) != 0 {
 		err := db.WithContext(ctx).Clauses(clause.OnConflict{
 			Columns: []clause.Column{{Name: `pool`}, {Name: `pool_id`}},
@@ -173,6 +211,186 @@ func (repo *stakingRepo) Save(ctx context.Context, blockNumber uint64, roots ...
 	return nil
 }
 
+func (repo *stakingRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	if err := rollbackJournals(db.WithContext(ctx), stakingPoolJournalSchema, blockNumber); err != nil {
+		return err
+	}
+
+	if err := rollbackJournals(db.WithContext(ctx), stakingBalanceJournalSchema, blockNumber); err != nil {
+		return err
+	}
+
+	if err := rollbackStakingBalanceChanges(db.WithContext(ctx), blockNumber); err != nil {
+		return err
+	}
+
+	return rollbackJournals(db.WithContext(ctx), stakingPositionJournalSchema, blockNumber)
+}
+
+func (repo *stakingRepo) QueryPools(ctx context.Context, opts staking.PoolQueryOptions) ([]*staking.StakingPool, string, error) {
+	db := repo.db.WithContext(ctx)
+	if opts.Owner != "" {
+		db = db.Where("owner = ?", opts.Owner)
+	}
+
+	ms, next, err := queryPageByID(db, opts.Cursor, opts.Size, func(m *models.StakingPool) int64 { return m.ID })
+	if err != nil {
+		return nil, "", err
+	}
+
+	var entities = make([]*staking.StakingPool, 0, len(ms))
+	for _, m := range ms {
+		entity, err := acl.ConvertPoolModelToEntity(m)
+		if err != nil {
+			return nil, "", err
+		}
+
+		entities = append(entities, entity)
+	}
+
+	return entities, next, nil
+}
+
+func (repo *stakingRepo) QueryPool(ctx context.Context, pool string, poolSubID uint64) (*staking.StakingPool, error) {
+	var m models.StakingPool
+	if err := repo.db.WithContext(ctx).Where("pool = ? and pool_id = ?", pool, poolSubID).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return acl.ConvertPoolModelToEntity(&m)
+}
+
+func (repo *stakingRepo) QueryPositions(ctx context.Context, opts staking.PositionQueryOptions) ([]*staking.StakingPosition, string, error) {
+	db := repo.db.WithContext(ctx)
+	if opts.Staker != "" {
+		db = db.Where("staker = ?", opts.Staker)
+	}
+
+	if opts.Pool != "" {
+		db = db.Where("pool = ?", opts.Pool)
+	}
+
+	ms, next, err := queryPageByID(db, opts.Cursor, opts.Size, func(m *models.StakingPosition) int64 { return m.ID })
+	if err != nil {
+		return nil, "", err
+	}
+
+	var entities = make([]*staking.StakingPosition, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertPositionModelToEntity(m))
+	}
+
+	return entities, next, nil
+}
+
+func (repo *stakingRepo) QueryStakedBalancesAt(ctx context.Context, tick string, blockNumber uint64) ([]*staking.StakedBalance, error) {
+	db := repo.db.WithContext(ctx)
+
+	// snapshots are the first change of a balance, one above the block hides the balance at the block.
+	var snapshotBlock uint64
+	err := db.Model(&models.StakingBalanceChange{}).
+		Select("COALESCE(MAX(block_number), 0)").
+		Where("tick = ? and snapshot = ?", tick, true).
+		Scan(&snapshotBlock).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshotBlock > blockNumber {
+		return nil, fmt.Errorf("%w, oldest height: %d", staking.ErrHistoryUnavailable, snapshotBlock)
+	}
+
+	latest := db.Model(&models.StakingBalanceChange{}).
+		Select("staker, pool, pool_id, MAX(block_number) AS block_number").
+		Where("tick = ? and block_number <= ?", tick, blockNumber).
+		Group("staker, pool, pool_id")
+
+	var ms []*models.StakingBalanceChange
+	err = db.Table("? AS c", clause.Table{Name: (&models.StakingBalanceChange{}).TableName()}).
+		Select("c.*").
+		Joins("JOIN (?) AS l ON c.staker = l.staker AND c.pool = l.pool AND c.pool_id = l.pool_id AND c.block_number = l.block_number", latest).
+		Where("c.tick = ? and c.amount > 0", tick).
+		Order("c.staker ASC, c.pool ASC, c.pool_id ASC").
+		Find(&ms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var balances = make([]*staking.StakedBalance, 0, len(ms))
+	for _, m := range ms {
+		balances = append(balances, acl.ConvertStakingBalanceChangeModelToEntity(m))
+	}
+
+	return balances, nil
+}
+
+// saveStakingBalanceChanges records the staked balances changed by a block in the ledger.
+func saveStakingBalanceChanges(db *gorm.DB, blockNumber uint64, ms []*models.StakingBalance) error {
+	if len(ms) == 0 {
+		return nil
+	}
+
+	var changes = make([]*models.StakingBalanceChange, 0, len(ms))
+	for _, m := range ms {
+		changes = append(changes, acl.ConvertStakingBalanceToChangeModel(m, blockNumber))
+	}
+
+	// a snapshot of the same block is replaced.
+	return db.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(changes, 1000).Error
+}
+
+const stakingBalanceSnapshotSQL = "INSERT INTO `staking_balance_changes` " +
+	"(`staker`, `pool`, `pool_id`, `tick`, `block_number`, `amount`, `snapshot`, `created_at`) " +
+	"SELECT `staker`, `pool`, `pool_id`, `tick`, `block_number`, `amount`, true, NOW(3) FROM `staking_balances`"
+
+// SeedStakingBalanceChanges records the existing staked balances as snapshots, when the ledger is created
+// over an indexed database.
+func SeedStakingBalanceChanges(db *gorm.DB) error {
+	if err := db.Where("1 = 1").Delete(&models.StakingBalanceChange{}).Error; err != nil {
+		return err
+	}
+
+	return db.Exec(stakingBalanceSnapshotSQL).Error
+}
+
+// rollbackStakingBalanceChanges deletes the changes above the block, the snapshots deleted are taken again
+// from the staked balances restored by the journals.
+func rollbackStakingBalanceChanges(db *gorm.DB, blockNumber uint64) error {
+	var keys []*models.StakingBalanceChange
+	err := db.Select("staker", "pool", "pool_id", "tick").
+		Where("block_number > ? and snapshot = ?", blockNumber, true).
+		Find(&keys).Error
+	if err != nil {
+		return err
+	}
+
+	if err := db.Where("block_number > ?", blockNumber).Delete(&models.StakingBalanceChange{}).Error; err != nil {
+		return err
+	}
+
+	for _, batch := range chunk(keys, loadBatchSize) {
+		var values = make([][]any, 0, len(batch))
+		for _, key := range batch {
+			values = append(values, []any{key.Staker, key.Pool, key.PoolID, key.Tick})
+		}
+
+		if err := db.Exec(stakingBalanceSnapshotSQL+" WHERE (`staker`, `pool`, `pool_id`, `tick`) IN ?", values).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func NewStakingRepository(db *gorm.DB) staking.StakingRepository {
 	return &stakingRepo{db: db}
 }
@@ -0,0 +1,115 @@
+package mysqlimpl
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
+	rctx "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/context"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// openTestDB connects the database of INDEXER_TEST_MYSQL_DSN, the tables are emptied.
+func openTestDB(t *testing.T, tables ...any) *gorm.DB {
+	dsn := os.Getenv("INDEXER_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("INDEXER_TEST_MYSQL_DSN is not set")
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	if !assert.NoError(t, db.AutoMigrate(tables...)) {
+		t.FailNow()
+	}
+
+	for _, table := range tables {
+		assert.NoError(t, db.Where("1 = 1").Delete(table).Error)
+	}
+
+	return db
+}
+
+func newTestStakingAggregate(blockNumber uint64, amounts map[string]int64) *staking.PoolAggregate {
+	const pool = "0x00000000000000000000000000000000000000aa"
+
+	aggregate := staking.NewPoolAggregate(pool, "")
+	aggregate.InitPool(&staking.StakingPool{Pool: pool, PoolSubID: 1})
+
+	for staker, amount := range amounts {
+		position := staking.NewStakingPosition(blockNumber, pool, 1, staker)
+		position.TickDetails["ethi"] = &staking.PositionTickDetail{Tick: "ethi", Amount: decimal.NewFromInt(amount)}
+		aggregate.InitPosition(position)
+	}
+
+	return aggregate
+}
+
+func TestQueryStakedBalancesAt(t *testing.T) {
+	const (
+		alice = "0x0000000000000000000000000000000000000001"
+		bob   = "0x0000000000000000000000000000000000000002"
+	)
+
+	db := openTestDB(t, &models.StakingPool{}, &models.StakingPosition{}, &models.StakingBalance{}, &models.StakingBalanceChange{}, &models.StateJournal{})
+	repo := NewStakingRepository(db)
+
+	save := func(blockNumber uint64, amounts map[string]int64) {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			return repo.Save(rctx.WithTransactionDB(context.Background(), tx), blockNumber, newTestStakingAggregate(blockNumber, amounts))
+		})
+		assert.NoError(t, err)
+	}
+
+	amounts := func(balances []*staking.StakedBalance) map[string]string {
+		var result = make(map[string]string, len(balances))
+		for _, b := range balances {
+			result[b.Staker] = b.Amount.String()
+		}
+		return result
+	}
+
+	// alice stakes at block 10, her stake changes and bob stakes at block 20.
+	save(10, map[string]int64{alice: 100})
+	save(20, map[string]int64{alice: 40, bob: 30})
+
+	balances, err := repo.QueryStakedBalancesAt(context.Background(), "ethi", 15)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{alice: "100"}, amounts(balances))
+
+	balances, err = repo.QueryStakedBalancesAt(context.Background(), "ethi", 20)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{alice: "40", bob: "30"}, amounts(balances))
+
+	balances, err = repo.QueryStakedBalancesAt(context.Background(), "ethi", 5)
+	assert.NoError(t, err)
+	assert.Empty(t, balances)
+
+	// block 20 is rolled back.
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return repo.Rollback(rctx.WithTransactionDB(context.Background(), tx), 15)
+	})
+	assert.NoError(t, err)
+
+	balances, err = repo.QueryStakedBalancesAt(context.Background(), "ethi", 20)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{alice: "100"}, amounts(balances))
+
+	// the ledger seeded over the staked balances does not know the history before.
+	assert.NoError(t, SeedStakingBalanceChanges(db))
+
+	_, err = repo.QueryStakedBalancesAt(context.Background(), "ethi", 5)
+	assert.ErrorIs(t, err, staking.ErrHistoryUnavailable)
+
+	balances, err = repo.QueryStakedBalancesAt(context.Background(), "ethi", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{alice: "100"}, amounts(balances))
+}
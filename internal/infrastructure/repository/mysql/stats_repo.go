@@ -0,0 +1,297 @@
+package mysqlimpl
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/market"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	rctx "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/context"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type statsRepo struct {
+	db *gorm.DB
+}
+
+func NewStatsRepository(db *gorm.DB) market.StatsRepository {
+	return &statsRepo{db: db}
+}
+
+func (repo *statsRepo) Save(ctx context.Context, stats ...*market.TickStats) error {
+
+	if len(stats) == 0 || rctx.UpdateKindFromContext(ctx) != rctx.UpdateDB {
+		return nil
+	}
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	for _, s := range stats {
+		prev, err := latestTickStats(dbWithTx, s.Tick)
+		if err != nil {
+			return err
+		}
+
+		s.Accumulate(prev)
+		if err := dbWithTx.Create(acl.ConvertTickStatsEntityToModel(s)).Error; err != nil {
+			return err
+		}
+
+		if err := rollDailyStats(dbWithTx, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func latestTickStats(db *gorm.DB, tick string) (*market.TickStats, error) {
+	var m models.MarketTickStats
+	if err := db.Where("tick = ?", tick).Order("block_number DESC").Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return acl.ConvertTickStatsModelToEntity(&m), nil
+}
+
+func rollDailyStats(db *gorm.DB, s *market.TickStats) error {
+	var m models.MarketTickDailyStats
+	err := db.Where("tick = ? and day = ?", s.Tick, s.Day).Take(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return db.Create(acl.ConvertDailyStatsEntityToModel(market.NewDayStats(s))).Error
+	}
+
+	if err != nil {
+		return err
+	}
+
+	day := acl.ConvertDailyStatsModelToEntity(&m)
+	day.Roll(s)
+
+	updated := acl.ConvertDailyStatsEntityToModel(day)
+	updated.ID, updated.CreatedAt = m.ID, m.CreatedAt
+	return db.Save(updated).Error
+}
+
+// Rollback deletes the statistics above the block and rolls up again the days they were in.
+// the snapshots deleted are taken again from the events and balances at the block.
+func (repo *statsRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+
+	if rctx.UpdateKindFromContext(ctx) != rctx.UpdateDB {
+		return nil
+	}
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	var snapshots []string
+	err := dbWithTx.Model(&models.MarketTickStats{}).
+		Where("block_number > ? and snapshot = ?", blockNumber, true).
+		Pluck("tick", &snapshots).Error
+	if err != nil {
+		return err
+	}
+
+	var days []*models.MarketTickDailyStats
+	if err := dbWithTx.Select("tick", "day").Where("block_number > ?", blockNumber).Find(&days).Error; err != nil {
+		return err
+	}
+
+	if err := dbWithTx.Where("block_number > ?", blockNumber).Delete(&models.MarketTickStats{}).Error; err != nil {
+		return err
+	}
+
+	if err := dbWithTx.Where("block_number > ?", blockNumber).Delete(&models.MarketTickDailyStats{}).Error; err != nil {
+		return err
+	}
+
+	for _, day := range days {
+		var ms []*models.MarketTickStats
+		err := dbWithTx.
+			Where("tick = ? and day = ? and snapshot = ?", day.Tick, day.Day, false).
+			Order("block_number ASC").
+			Find(&ms).Error
+		if err != nil {
+			return err
+		}
+
+		for _, m := range ms {
+			if err := rollDailyStats(dbWithTx, acl.ConvertTickStatsModelToEntity(m)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	return seedTickStats(dbWithTx, snapshots)
+}
+
+func (repo *statsRepo) QueryLatest(ctx context.Context, tick string) (*market.TickStats, error) {
+	return latestTickStats(repo.db.WithContext(ctx), tick)
+}
+
+func (repo *statsRepo) QueryStats(ctx context.Context, opts market.StatsQueryOptions) ([]*market.TickStats, string, error) {
+	db := repo.db.WithContext(ctx).Where("tick = ?", opts.Tick).Order("block_number DESC")
+	if opts.Cursor != "" {
+		values, err := utils.DecodeCursor(opts.Cursor, 1)
+		if err != nil {
+			return nil, "", err
+		}
+
+		blockNumber, err := strconv.ParseUint(values[0], 10, 64)
+		if err != nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+		db = db.Where("block_number < ?", blockNumber)
+	}
+
+	var entities []*market.TickStats
+	switch opts.Period {
+	case market.StatsPeriodDay:
+		var ms []*models.MarketTickDailyStats
+		if err := db.Limit(opts.Size + 1).Find(&ms).Error; err != nil {
+			return nil, "", err
+		}
+
+		for _, m := range ms {
+			entities = append(entities, acl.ConvertDailyStatsModelToEntity(m))
+		}
+
+	default:
+		var ms []*models.MarketTickStats
+		if err := db.Where("snapshot = ?", false).Limit(opts.Size + 1).Find(&ms).Error; err != nil {
+			return nil, "", err
+		}
+
+		for _, m := range ms {
+			entities = append(entities, acl.ConvertTickStatsModelToEntity(m))
+		}
+	}
+
+	if len(entities) <= opts.Size {
+		return entities, "", nil
+	}
+
+	entities = entities[:opts.Size]
+	return entities, utils.EncodeCursor(strconv.FormatUint(entities[opts.Size-1].BlockNumber, 10)), nil
+}
+
+// SeedTickStats records the statistics of the indexed ticks as snapshots, when the statistics are
+// created over an indexed database. it replays the mint and transfer events once.
+func SeedTickStats(db *gorm.DB) error {
+	if err := db.Where("1 = 1").Delete(&models.MarketTickStats{}).Error; err != nil {
+		return err
+	}
+
+	if err := db.Where("1 = 1").Delete(&models.MarketTickDailyStats{}).Error; err != nil {
+		return err
+	}
+
+	return seedTickStats(db, nil)
+}
+
+// seedTickStats takes a snapshot of the ticks, all of them if nil, at their last event.
+func seedTickStats(db *gorm.DB, ticks []string) error {
+	var (
+		seeds = make(map[string]*market.TickStats)
+		ms    []*models.Event
+	)
+
+	query := db.Where("operate IN ? and err_code = ?", []string{
+		string(protocol.OpMint),
+		string(protocol.OpTransfer),
+		string(protocol.OpProxyTransfer),
+	}, 0)
+	if ticks != nil {
+		query = query.Where("tick IN ?", ticks)
+	}
+
+	err := query.FindInBatches(&ms, 1000, func(tx *gorm.DB, batch int) error {
+		for start := 0; start < len(ms); {
+			end := start
+			for end < len(ms) && ms[end].BlockNumber == ms[start].BlockNumber {
+				end++
+			}
+
+			var events = make([]domain.Event, 0, end-start)
+			for _, m := range ms[start:end] {
+				events = append(events, acl.ConvertModelToEvent(m))
+			}
+
+			for _, s := range market.NewBlockStats(ms[start].BlockNumber, events, nil, nil) {
+				s.Accumulate(seeds[s.Tick])
+				seeds[s.Tick] = s
+			}
+
+			start = end
+		}
+
+		return nil
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(seeds) == 0 {
+		return nil
+	}
+
+	var holders []struct {
+		Tick  string
+		Count int64
+	}
+	query = db.Model(&models.IERC20Balance{}).
+		Select("tick, COUNT(*) AS count").
+		Where(balanceTotalExpr + " > 0").
+		Group("tick")
+	if ticks != nil {
+		query = query.Where("tick IN ?", ticks)
+	}
+
+	if err := query.Find(&holders).Error; err != nil {
+		return err
+	}
+
+	for _, h := range holders {
+		if s, existed := seeds[h.Tick]; existed {
+			s.Holders = h.Count
+		}
+	}
+
+	var snapshots = make([]*models.MarketTickStats, 0, len(seeds))
+	for _, s := range seeds {
+		m := acl.ConvertTickStatsEntityToModel(&market.TickStats{
+			Tick:           s.Tick,
+			BlockNumber:    s.BlockNumber,
+			Day:            s.Day,
+			Holders:        s.Holders,
+			TotalTransfers: s.TotalTransfers,
+			TotalMints:     s.TotalMints,
+			TotalMinted:    s.TotalMinted,
+			TotalTrades:    s.TotalTrades,
+			TotalVolume:    s.TotalVolume,
+			LastPrice:      s.LastPrice,
+		})
+		m.Snapshot = true
+		snapshots = append(snapshots, m)
+	}
+
+	return db.CreateInBatches(snapshots, 1000).Error
+}
@@ -8,10 +8,17 @@ import (
 	rctx "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/context"
 	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/acl"
 	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+var tickJournalSchema = &journalSchema[models.IERCTick]{
+	table:   (&models.IERCTick{}).TableName(),
+	columns: []string{`tick`},
+	key:     func(m *models.IERCTick) []any { return []any{m.Tick} },
+}
+
 type tickRepo struct {
 	db *gorm.DB
 }
@@ -30,6 +37,70 @@ func (repo *tickRepo) Load(ctx context.Context, tick string) (domain.Tick, error
 	return acl.ConvertTickModelToEntity(&m)
 }
 
+func (repo *tickRepo) LoadMany(ctx context.Context, names []string) (map[string]domain.Tick, error) {
+	var entities = make(map[string]domain.Tick, len(names))
+
+	for _, batch := range chunk(names, loadBatchSize) {
+		var ms []*models.IERCTick
+		if err := repo.db.WithContext(ctx).Where("tick IN ?", batch).Find(&ms).Error; err != nil {
+			return nil, err
+		}
+
+		for _, m := range ms {
+			entity, err := acl.ConvertTickModelToEntity(m)
+			if err != nil {
+				return nil, err
+			}
+
+			entities[entity.GetName()] = entity
+		}
+	}
+
+	return entities, nil
+}
+
+func (repo *tickRepo) QueryTicks(ctx context.Context, opts domain.QueryOptions) ([]domain.Tick, string, error) {
+	db := repo.db.WithContext(ctx).Order("tick ASC").Limit(opts.Size + 1)
+	if opts.Protocol != "" {
+		db = db.Where("protocol = ?", opts.Protocol)
+	}
+
+	if opts.Prefix != "" {
+		db = db.Where("tick LIKE ?", likeEscaper.Replace(opts.Prefix)+"%")
+	}
+
+	if opts.Cursor != "" {
+		values, err := utils.DecodeCursor(opts.Cursor, 1)
+		if err != nil {
+			return nil, "", err
+		}
+		db = db.Where("tick > ?", values[0])
+	}
+
+	var ms []*models.IERCTick
+	if err := db.Find(&ms).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(ms) > opts.Size {
+		ms = ms[:opts.Size]
+		next = utils.EncodeCursor(ms[len(ms)-1].Tick)
+	}
+
+	var entities = make([]domain.Tick, 0, len(ms))
+	for _, m := range ms {
+		entity, err := acl.ConvertTickModelToEntity(m)
+		if err != nil {
+			return nil, "", err
+		}
+
+		entities = append(entities, entity)
+	}
+
+	return entities, next, nil
+}
+
 func (repo *tickRepo) Save(ctx context.Context, entities ...domain.Tick) error {
 
 	if len(entities) == 0 {
@@ -41,9 +112,20 @@ func (repo *tickRepo) Save(ctx context.Context, entities ...domain.Tick) error {
 		panic("missing db instance")
 	}
 
-	var ms []*models.IERCTick
+	var (
+		ms      []*models.IERCTick
+		msBlock = make(map[uint64][]*models.IERCTick)
+	)
 	for _, entity := range entities {
-		ms = append(ms, acl.ConvertTickEntityToModel(entity))
+		m := acl.ConvertTickEntityToModel(entity)
+		ms = append(ms, m)
+		msBlock[m.LastUpdatedBlock] = append(msBlock[m.LastUpdatedBlock], m)
+	}
+
+	for blockNumber, items := range msBlock {
+		if err := saveJournals(db.WithContext(ctx), tickJournalSchema, blockNumber, items); err != nil {
+			return err
+		}
 	}
 
 	return db.WithContext(ctx).Clauses(clause.OnConflict{
@@ -58,6 +140,15 @@ func (repo *tickRepo) Save(ctx context.Context, entities ...domain.Tick) error {
 	}).CreateInBatches(ms, 1000).Error
 }
 
+func (repo *tickRepo) Rollback(ctx context.Context, blockNumber uint64) error {
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	return rollbackJournals(db.WithContext(ctx), tickJournalSchema, blockNumber)
+}
+
 func NewTickRepo(db *gorm.DB) domain.TickRepository {
 	return &tickRepo{db: db}
 }
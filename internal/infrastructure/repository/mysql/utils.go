@@ -0,0 +1,52 @@
+package mysqlimpl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/IErcOrg/IERC_Indexer/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// loadBatchSize bounds the number of keys of a `WHERE IN` query.
+const loadBatchSize = 500
+
+func chunk[T any](items []T, size int) [][]T {
+	var chunks = make([][]T, 0, (len(items)+size-1)/size)
+	for start := 0; start < len(items); start += size {
+		chunks = append(chunks, items[start:min(start+size, len(items))])
+	}
+
+	return chunks
+}
+
+// likeEscaper escapes the wildcards of a `LIKE` pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// queryPageByID loads a page of rows ordered by id, the cursor of the next page carries the id of the last row.
+func queryPageByID[T any](db *gorm.DB, cursor string, size int, id func(*T) int64) ([]*T, string, error) {
+	if cursor != "" {
+		values, err := utils.DecodeCursor(cursor, 1)
+		if err != nil {
+			return nil, "", err
+		}
+
+		lastID, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return nil, "", utils.ErrInvalidCursor
+		}
+		db = db.Where("id > ?", lastID)
+	}
+
+	var ms []*T
+	if err := db.Order("id ASC").Limit(size + 1).Find(&ms).Error; err != nil {
+		return nil, "", err
+	}
+
+	if len(ms) <= size {
+		return ms, "", nil
+	}
+
+	ms = ms[:size]
+	return ms, utils.EncodeCursor(strconv.FormatInt(id(ms[size-1]), 10)), nil
+}
@@ -0,0 +1,126 @@
+package mysqlimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/webhook"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const webhookCursorID = 1
+
+type webhookRepo struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) webhook.Repository {
+	return &webhookRepo{db: db}
+}
+
+func (repo *webhookRepo) CreateEndpoint(ctx context.Context, endpoint *webhook.Endpoint) error {
+	m := acl.ConvertWebhookEndpointToModel(endpoint)
+	if err := repo.db.WithContext(ctx).Create(m).Error; err != nil {
+		return err
+	}
+
+	endpoint.ID, endpoint.CreatedAt = m.ID, m.CreatedAt
+	return nil
+}
+
+func (repo *webhookRepo) DeleteEndpoint(ctx context.Context, id int64) (bool, error) {
+	result := repo.db.WithContext(ctx).Where("`id` = ?", id).Delete(&models.WebhookEndpoint{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (repo *webhookRepo) ListEndpoints(ctx context.Context) ([]*webhook.Endpoint, error) {
+	var ms []*models.WebhookEndpoint
+	if err := repo.db.WithContext(ctx).Order("`id` ASC").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var endpoints = make([]*webhook.Endpoint, 0, len(ms))
+	for _, m := range ms {
+		endpoints = append(endpoints, acl.ConvertWebhookEndpointModelToEntity(m))
+	}
+
+	return endpoints, nil
+}
+
+func (repo *webhookRepo) GetCursor(ctx context.Context) (*domain.EventCursor, error) {
+	var m models.WebhookCursor
+	err := repo.db.WithContext(ctx).Where("`id` = ?", webhookCursorID).Take(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &domain.EventCursor{BlockNumber: m.BlockNumber, EventID: m.EventID}, nil
+}
+
+func (repo *webhookRepo) Enqueue(ctx context.Context, cursor domain.EventCursor, deliveries []*webhook.Delivery) error {
+	return repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(deliveries) > 0 {
+			var ms = make([]*models.WebhookDelivery, 0, len(deliveries))
+			for _, delivery := range deliveries {
+				ms = append(ms, acl.ConvertWebhookDeliveryToModel(delivery))
+			}
+
+			if err := tx.CreateInBatches(ms, 1000).Error; err != nil {
+				return err
+			}
+
+			for i, m := range ms {
+				deliveries[i].ID = m.ID
+			}
+		}
+
+		m := &models.WebhookCursor{ID: webhookCursorID, BlockNumber: cursor.BlockNumber, EventID: cursor.EventID}
+		return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(m).Error
+	})
+}
+
+func (repo *webhookRepo) LoadDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	var ms []*models.WebhookDelivery
+	err := repo.db.WithContext(ctx).
+		Where("`status` = ? and `next_attempt_at` <= ?", webhook.DeliveryPending, now).
+		Order("`id` ASC").
+		Limit(limit).
+		Find(&ms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries = make([]*webhook.Delivery, 0, len(ms))
+	for _, m := range ms {
+		deliveries = append(deliveries, acl.ConvertWebhookDeliveryModelToEntity(m))
+	}
+
+	return deliveries, nil
+}
+
+func (repo *webhookRepo) UpdateDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	return repo.db.WithContext(ctx).
+		Model(&models.WebhookDelivery{}).
+		Where("`id` = ?", delivery.ID).
+		Updates(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"last_error":      delivery.LastError,
+		}).Error
+}
+
+func (repo *webhookRepo) PurgeDeliveries(ctx context.Context, before time.Time) error {
+	return repo.db.WithContext(ctx).
+		Where("`status` <> ? and `updated_at` < ?", webhook.DeliveryPending, before).
+		Delete(&models.WebhookDelivery{}).Error
+}
@@ -0,0 +1,201 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultBatchSize      = 10
+	defaultMaxConcurrency = 16
+	maxBatchRetries       = 5
+)
+
+type rpcBlock struct {
+	Transactions []*types.Transaction `json:"transactions"`
+	Withdrawals  []*types.Withdrawal  `json:"withdrawals,omitempty"`
+}
+
+// RangeSize returns a batch for every request the limiter currently allows in flight.
+func (e *EthereumFetcher) RangeSize() uint64 {
+	return e.rangeBatchSize() * uint64(e.limiter.Limit())
+}
+
+func (e *EthereumFetcher) rangeBatchSize() uint64 {
+	if e.pool.quorum > 1 {
+		return 1
+	}
+
+	return uint64(e.batchSize)
+}
+
+// GetBlocksByRange fetches blocks [startAt, startAt+size) with json-rpc batch requests, or from the prefilter if configured.
+// with a quorum above one every block is fetched with GetBlockByNumber instead, since batches come from a single endpoint.
+func (e *EthereumFetcher) GetBlocksByRange(ctx context.Context, startAt uint64, size uint64) ([]*domain.Block, error) {
+	var (
+		blocks    = make([]*domain.Block, size)
+		batchSize = e.rangeBatchSize()
+		gg, gCtx  = errgroup.WithContext(ctx)
+	)
+
+	for offset := uint64(0); offset < size; offset += batchSize {
+		offset, count := offset, min(batchSize, size-offset)
+
+		gg.Go(func() error {
+			for retry := 0; ; retry++ {
+				if err := e.limiter.Acquire(gCtx); err != nil {
+					return err
+				}
+
+				begin := time.Now()
+				result, err := e.fetchBatch(gCtx, startAt+offset, count)
+				e.limiter.Release(time.Since(begin), err)
+
+				if err == nil {
+					copy(blocks[offset:], result)
+					return nil
+				}
+
+				if !isRateLimited(err) || retry+1 >= maxBatchRetries {
+					return err
+				}
+
+				e.logger.Warnf("rate limited. start: %d, size: %d, limit: %d, retry: %d", startAt+offset, count, e.limiter.Limit(), retry)
+
+				select {
+				case <-gCtx.Done():
+					return gCtx.Err()
+				case <-time.After(time.Second * time.Duration(retry+1)):
+				}
+			}
+		})
+	}
+
+	if err := gg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+func (e *EthereumFetcher) fetchBatch(ctx context.Context, startAt uint64, size uint64) ([]*domain.Block, error) {
+	switch {
+	case e.prefilter != nil:
+		filtered, err := e.prefilter.FilterBlocks(ctx, startAt, size)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkFilteredBlocks(filtered, startAt, size); err != nil {
+			return nil, err
+		}
+
+		blocks := make([]*domain.Block, 0, size)
+		for _, item := range filtered {
+			block, err := parseFilteredBlock(e.parser, item)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, block)
+		}
+
+		return blocks, nil
+
+	case e.pool.quorum > 1:
+		block, err := e.GetBlockByNumber(ctx, startAt)
+		if err != nil {
+			return nil, err
+		}
+		return []*domain.Block{block}, nil
+
+	default:
+		raws, err := e.getRawBlocks(ctx, startAt, size)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks := make([]*domain.Block, 0, size)
+		for _, raw := range raws {
+			block, err := parseBlock(e.parser, raw)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, block)
+		}
+
+		return blocks, nil
+	}
+}
+
+func (e *EthereumFetcher) getRawBlocks(ctx context.Context, startAt uint64, size uint64) ([]*types.Block, error) {
+	return call(ctx, e.pool, func(cli *ethclient.Client) ([]*types.Block, error) {
+		var (
+			raws = make([]json.RawMessage, size)
+			reqs = make([]rpc.BatchElem, size)
+		)
+
+		for i := range reqs {
+			reqs[i] = rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []any{hexutil.EncodeUint64(startAt + uint64(i)), true},
+				Result: &raws[i],
+			}
+		}
+
+		if err := cli.Client().BatchCallContext(ctx, reqs); err != nil {
+			return nil, err
+		}
+
+		blocks := make([]*types.Block, 0, size)
+		for i, req := range reqs {
+			if req.Error != nil {
+				return nil, req.Error
+			}
+
+			block, err := decodeBlock(raws[i])
+			if err != nil {
+				return nil, fmt.Errorf("decode block %d failed: %w", startAt+uint64(i), err)
+			}
+			blocks = append(blocks, block)
+		}
+
+		return blocks, nil
+	})
+}
+
+// decodeBlock decodes the result of eth_getBlockByNumber. uncles are not loaded, they are not used by the indexer
+// and do not change the block hash.
+func decodeBlock(raw json.RawMessage) (*types.Block, error) {
+	var head *types.Header
+	if err := json.Unmarshal(raw, &head); err != nil {
+		return nil, err
+	}
+
+	if head == nil {
+		return nil, errors.New("block not found")
+	}
+
+	var body rpcBlock
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, err
+	}
+
+	if head.TxHash == types.EmptyTxsHash && len(body.Transactions) > 0 {
+		return nil, errors.New("server returned non-empty transaction list but block header indicates no transactions")
+	}
+	if head.TxHash != types.EmptyTxsHash && len(body.Transactions) == 0 {
+		return nil, errors.New("server returned empty transaction list but block header indicates transactions")
+	}
+
+	return types.NewBlockWithHeader(head).WithBody(body.Transactions, nil).WithWithdrawals(body.Withdrawals), nil
+}
@@ -0,0 +1,115 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params []any           `json:"params"`
+}
+
+func newBatchServer(t *testing.T, limited int32) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= limited {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		var reqs []rpcRequest
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqs)) {
+			return
+		}
+
+		var replies []map[string]any
+		for _, req := range reqs {
+			number, _ := hexutil.DecodeUint64(req.Params[0].(string))
+			header, _ := json.Marshal(&types.Header{
+				ParentHash: common.BigToHash(new(big.Int).SetUint64(number - 1)),
+				UncleHash:  types.EmptyUncleHash,
+				TxHash:     types.EmptyTxsHash,
+				Difficulty: common.Big0,
+				Number:     new(big.Int).SetUint64(number),
+			})
+			replies = append(replies, map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": json.RawMessage(header)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(replies)
+	}))
+
+	return srv, &requests
+}
+
+func newTestFetcher(t *testing.T, url string, batchSize int) *EthereumFetcher {
+	c, err := rpc.Dial(url)
+	assert.NoError(t, err)
+
+	helper := log.NewHelper(log.DefaultLogger)
+	return &EthereumFetcher{
+		pool:      newClientPool([]*endpoint{{cli: ethclient.NewClient(c)}}, 1, 10, time.Minute, helper),
+		limiter:   newAdaptiveLimiter(1, 4),
+		batchSize: batchSize,
+		logger:    helper,
+	}
+}
+
+func TestGetBlocksByRange(t *testing.T) {
+	srv, requests := newBatchServer(t, 1)
+	defer srv.Close()
+
+	fetcher := newTestFetcher(t, srv.URL, 4)
+	assert.Equal(t, uint64(4), fetcher.RangeSize())
+
+	blocks, err := fetcher.GetBlocksByRange(context.Background(), 100, 10)
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 10)
+	for i, block := range blocks {
+		assert.Equal(t, uint64(100+i), block.Number)
+	}
+	assert.Equal(t, int32(4), requests.Load())
+
+	// a round grows with the limit of the requests in flight.
+	assert.Equal(t, uint64(4*fetcher.limiter.Limit()), fetcher.RangeSize())
+}
+
+func TestAdaptiveLimiter(t *testing.T) {
+	l := newAdaptiveLimiter(1, 4)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, l.Acquire(ctx))
+		l.Release(time.Millisecond, nil)
+	}
+	assert.Equal(t, 4, l.Limit())
+
+	assert.NoError(t, l.Acquire(ctx))
+	l.Release(time.Millisecond, rpc.HTTPError{StatusCode: http.StatusTooManyRequests})
+	assert.Equal(t, 2, l.Limit())
+
+	assert.NoError(t, l.Acquire(ctx))
+	l.Release(time.Second, nil)
+	assert.Equal(t, 1, l.Limit())
+
+	assert.NoError(t, l.Acquire(ctx))
+	timeout, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+	assert.Error(t, l.Acquire(timeout))
+}
@@ -3,6 +3,7 @@ package ethereum
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -19,9 +20,13 @@ import (
 )
 
 type EthereumFetcher struct {
-	clis   []*ethclient.Client
-	parser parser.Parser
-	logger *log.Helper
+	pool       *clientPool
+	limiter    *adaptiveLimiter
+	batchSize  int
+	wsEndpoint string
+	prefilter  Prefilter
+	parser     parser.Parser
+	logger     *log.Helper
 }
 
 func NewEthereumFetcher(conf *conf.Config, parser parser.Parser, logger log.Logger) (domain.BlockFetcher, error) {
@@ -31,25 +36,78 @@ func NewEthereumFetcher(conf *conf.Config, parser parser.Parser, logger log.Logg
 		return nil, errors.New("missing ethereum rpc endpoints")
 	}
 
-	var clis []*ethclient.Client
-	for _, endpoint := range data.Ethereum.Endpoints {
-		c, err := rpc.DialOptions(context.Background(), endpoint)
+	fetcher, err := newEthereumFetcher(data.Ethereum, data.Ethereum.Endpoints, parser, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	switch data.Ethereum.Prefilter {
+	case "":
+
+	case PrefilterLocal:
+		// the prefiltered blocks are read from a single source, they cannot be verified by a quorum.
+		if data.Ethereum.Quorum > 1 {
+			return nil, errors.New("prefilter cannot be used with a quorum above one")
+		}
+
+		source := fetcher
+		if len(data.Ethereum.PrefilterEndpoints) != 0 {
+			source, err = newEthereumFetcher(data.Ethereum, data.Ethereum.PrefilterEndpoints, parser, logger)
+			if err != nil {
+				return nil, err
+			}
+		}
+		fetcher.prefilter = newLocalPrefilter(source, protocol.ProtocolHeader)
+
+	default:
+		return nil, fmt.Errorf("unknown prefilter: %s", data.Ethereum.Prefilter)
+	}
+
+	return fetcher, nil
+}
+
+func newEthereumFetcher(c *conf.Data_Ethereum, urls []string, parser parser.Parser, logger log.Logger) (*EthereumFetcher, error) {
+	var endpoints []*endpoint
+	for _, url := range urls {
+		cli, err := rpc.DialOptions(context.Background(), url)
 		if err != nil {
 			return nil, err
 		}
 
-		clis = append(clis, ethclient.NewClient(c))
+		endpoints = append(endpoints, &endpoint{cli: ethclient.NewClient(cli)})
+	}
+
+	batchSize := c.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	maxConcurrency := c.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultMaxConcurrency
 	}
 
+	helper := log.NewHelper(log.With(logger, "module", "fetcher"))
 	return &EthereumFetcher{
-		clis:   clis,
-		parser: parser,
-		logger: log.NewHelper(log.With(logger, "module", "fetcher")),
+		pool: newClientPool(
+			endpoints,
+			int(c.Quorum),
+			c.MaxFailures,
+			c.Cooldown.AsDuration(),
+			helper,
+		),
+		limiter:    newAdaptiveLimiter(1, int(maxConcurrency)),
+		batchSize:  int(batchSize),
+		wsEndpoint: c.WsEndpoint,
+		parser:     parser,
+		logger:     helper,
 	}, nil
 }
 
 func (e *EthereumFetcher) GetBlockNumber(ctx context.Context) (uint64, error) {
-	return e.clis[0].BlockNumber(ctx)
+	return call(ctx, e.pool, func(cli *ethclient.Client) (uint64, error) {
+		return cli.BlockNumber(ctx)
+	})
 }
 
 func (e *EthereumFetcher) GetBlockHeaderByNumber(ctx context.Context, blockNumber uint64) (*domain.BlockHeader, error) {
@@ -57,40 +115,104 @@ func (e *EthereumFetcher) GetBlockHeaderByNumber(ctx context.Context, blockNumbe
 	if blockNumber != 0 {
 		params = new(big.Int).SetUint64(blockNumber)
 	}
-	header, err := e.clis[0].HeaderByNumber(ctx, params)
+	header, err := call(ctx, e.pool, func(cli *ethclient.Client) (*types.Header, error) {
+		return cli.HeaderByNumber(ctx, params)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return convertHeader(header), nil
+}
+
+func (e *EthereumFetcher) GetBlockHeaderByTag(ctx context.Context, tag domain.BlockTag) (*domain.BlockHeader, error) {
+	var number rpc.BlockNumber
+	switch tag {
+	case domain.BlockTagSafe:
+		number = rpc.SafeBlockNumber
+	case domain.BlockTagFinalized:
+		number = rpc.FinalizedBlockNumber
+	default:
+		number = rpc.LatestBlockNumber
+	}
+
+	header, err := call(ctx, e.pool, func(cli *ethclient.Client) (*types.Header, error) {
+		return cli.HeaderByNumber(ctx, big.NewInt(number.Int64()))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertHeader(header), nil
+}
+
+func convertHeader(header *types.Header) *domain.BlockHeader {
 	return &domain.BlockHeader{
 		Number:     header.Number.Uint64(),
 		Hash:       header.Hash().String(),
 		ParentHash: header.ParentHash.String(),
-	}, nil
+	}
 }
 
+type blockVote struct {
+	hash    common.Hash
+	txCount int
+}
+
+// GetBlockByNumber returns the block once quorum endpoints agree on its hash and transactions.
 func (e *EthereumFetcher) GetBlockByNumber(ctx context.Context, targetBlock uint64) (*domain.Block, error) {
-	var block *types.Block
+	var (
+		lastErr = errNoEndpoint
+		blocks  = make(map[blockVote]*types.Block)
+		votes   = make(map[blockVote][]*endpoint)
+	)
 
-	for idx, cli := range e.clis {
-		cli := cli
-		newBlock, err := cli.BlockByNumber(ctx, new(big.Int).SetUint64(targetBlock))
+	for _, ep := range e.pool.available(e.pool.quorum) {
+		block, err := ep.cli.BlockByNumber(ctx, new(big.Int).SetUint64(targetBlock))
 		if err != nil {
-			return nil, err
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			e.pool.markFailure(ep, err)
+			lastErr = err
+			continue
+		}
+
+		vote := blockVote{hash: block.Hash(), txCount: block.Transactions().Len()}
+		blocks[vote] = block
+		votes[vote] = append(votes[vote], ep)
+		if len(votes[vote]) < e.pool.quorum {
+			continue
 		}
 
-		if block != nil && (newBlock.Hash() != block.Hash() || newBlock.Transactions().Len() != block.Transactions().Len()) {
-			e.logger.Warnf(
-				"block hash not match. client_idx: %d, last_number: %d, last_hash: %v, tx_count: %d, current_number: %d, current_hash: %v, tx_count: %d",
-				idx, block.NumberU64(), block.Hash(), block.Transactions().Len(), newBlock.NumberU64(), newBlock.Hash(), newBlock.Transactions().Len(),
-			)
-			return nil, errors.New("block inconsistent")
+		for other, eps := range votes {
+			if other == vote {
+				continue
+			}
+			for _, bad := range eps {
+				e.logger.Warnf(
+					"block hash not match. client_idx: %d, number: %d, hash: %v, tx_count: %d, quorum_hash: %v, quorum_tx_count: %d",
+					bad.idx, targetBlock, other.hash, other.txCount, vote.hash, vote.txCount,
+				)
+				e.pool.markFailure(bad, errors.New("block inconsistent"))
+			}
+		}
+		for _, good := range votes[vote] {
+			e.pool.markSuccess(good)
 		}
 
-		block = newBlock
+		return parseBlock(e.parser, block)
 	}
 
-	return e.parseBlock(block)
+	if len(votes) > 1 {
+		return nil, errors.New("block inconsistent")
+	}
+
+	if len(votes) == 1 {
+		return nil, errors.New("block quorum not reached")
+	}
+
+	return nil, lastErr
 }
 
 func GetTxSender(tx *types.Transaction) (common.Address, error) {
@@ -107,12 +229,20 @@ func GetTxSender(tx *types.Transaction) (common.Address, error) {
 	return sender, err
 }
 
-func (e *EthereumFetcher) parseBlock(block *types.Block) (*domain.Block, error) {
+func parseBlock(parser parser.Parser, block *types.Block) (*domain.Block, error) {
+	return parseFilteredBlock(parser, filterBlock(block, nil))
+}
+
+func parseFilteredBlock(parser parser.Parser, block *FilteredBlock) (*domain.Block, error) {
 
-	var transactions []*domain.Transaction
-	for position, tx := range block.Transactions() {
+	var (
+		header       = block.Header
+		transactions []*domain.Transaction
+	)
+	for _, item := range block.Transactions {
+		tx := item.Tx
 
-		err := e.parser.CheckFormat(tx.Data())
+		err := parser.CheckFormat(tx.Data())
 		if err != nil {
 			continue
 		}
@@ -128,8 +258,8 @@ func (e *EthereumFetcher) parseBlock(block *types.Block) (*domain.Block, error)
 		}
 
 		transactions = append(transactions, &domain.Transaction{
-			BlockNumber:     block.NumberU64(),
-			PositionInTxs:   int64(position),
+			BlockNumber:     header.Number.Uint64(),
+			PositionInTxs:   int64(item.Position),
 			Hash:            tx.Hash().String(),
 			From:            from.String(),
 			To:              to,
@@ -141,16 +271,16 @@ func (e *EthereumFetcher) parseBlock(block *types.Block) (*domain.Block, error)
 			IsProcessed:     false,
 			Code:            0,
 			Remark:          "",
-			CreatedAt:       time.Unix(int64(block.Time()), 0),
-			UpdatedAt:       time.Unix(int64(block.Time()), 0),
+			CreatedAt:       time.Unix(int64(header.Time), 0),
+			UpdatedAt:       time.Unix(int64(header.Time), 0),
 			IERCTransaction: nil,
 		})
 	}
 
 	return &domain.Block{
-		Number:           block.NumberU64(),
-		ParentHash:       block.ParentHash().String(),
-		Hash:             block.Hash().String(),
+		Number:           header.Number.Uint64(),
+		ParentHash:       header.ParentHash.String(),
+		Hash:             header.Hash().String(),
 		TransactionCount: len(transactions),
 		Transactions:     transactions,
 		IsProcessed:      len(transactions) == 0,
@@ -0,0 +1,212 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol/parser"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	archiveExtJSON = ".json"
+	archiveExtRLP  = ".rlp"
+	// blocks read by a sync round
+	archiveRangeSize = 100
+)
+
+// FileFetcher reads blocks from an archive directory instead of rpc endpoints.
+// every block is a file named by its number:
+//   - <number>.json: result of eth_getBlockByNumber with full transactions, or the whole json-rpc response.
+//   - <number>.rlp: rlp encoded block, as returned by debug_getRawBlock.
+type FileFetcher struct {
+	dir    string
+	parser parser.Parser
+	logger *log.Helper
+
+	// the highest block is scanned again only when the directory is modified.
+	mu          sync.Mutex
+	latest      uint64
+	scannedTime time.Time
+}
+
+func NewFileFetcher(dir string, parser parser.Parser, logger log.Logger) (domain.BlockFetcher, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("block archive is not a directory: %s", dir)
+	}
+
+	return &FileFetcher{
+		dir:    dir,
+		parser: parser,
+		logger: log.NewHelper(log.With(logger, "module", "file_fetcher")),
+	}, nil
+}
+
+// GetBlockNumber returns the highest block in the archive.
+func (f *FileFetcher) GetBlockNumber(_ context.Context) (uint64, error) {
+	info, err := os.Stat(f.dir)
+	if err != nil {
+		return 0, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.latest > 0 && info.ModTime().Equal(f.scannedTime) {
+		return f.latest, nil
+	}
+
+	latest, err := f.scanBlockNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	f.latest, f.scannedTime = latest, info.ModTime()
+	return latest, nil
+}
+
+func (f *FileFetcher) scanBlockNumber() (uint64, error) {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := filepath.Ext(name)
+		if entry.IsDir() || (ext != archiveExtJSON && ext != archiveExtRLP) {
+			continue
+		}
+
+		number, err := strconv.ParseUint(strings.TrimSuffix(name, ext), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		latest = max(latest, number)
+	}
+
+	if latest == 0 {
+		return 0, fmt.Errorf("no block found in archive: %s", f.dir)
+	}
+
+	return latest, nil
+}
+
+func (f *FileFetcher) GetBlockHeaderByNumber(ctx context.Context, blockNumber uint64) (*domain.BlockHeader, error) {
+	if blockNumber == 0 {
+		latest, err := f.GetBlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		blockNumber = latest
+	}
+
+	block, err := f.readBlock(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertHeader(block.Header()), nil
+}
+
+// GetBlockHeaderByTag returns the highest block for every tag, archived blocks are final.
+func (f *FileFetcher) GetBlockHeaderByTag(ctx context.Context, _ domain.BlockTag) (*domain.BlockHeader, error) {
+	return f.GetBlockHeaderByNumber(ctx, 0)
+}
+
+func (f *FileFetcher) GetBlockByNumber(_ context.Context, targetBlock uint64) (*domain.Block, error) {
+	block, err := f.readBlock(targetBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlock(f.parser, block)
+}
+
+func (f *FileFetcher) RangeSize() uint64 {
+	return archiveRangeSize
+}
+
+func (f *FileFetcher) GetBlocksByRange(ctx context.Context, startAt uint64, size uint64) ([]*domain.Block, error) {
+	blocks := make([]*domain.Block, 0, size)
+	for number := startAt; number < startAt+size; number++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		block, err := f.GetBlockByNumber(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+func (f *FileFetcher) SubscribeNewHead(_ context.Context) (<-chan *domain.BlockHeader, error) {
+	return nil, nil
+}
+
+func (f *FileFetcher) readBlock(number uint64) (*types.Block, error) {
+	name := filepath.Join(f.dir, strconv.FormatUint(number, 10))
+
+	data, err := os.ReadFile(name + archiveExtJSON)
+	if err == nil {
+		return decodeArchivedJSON(data)
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	data, err = os.ReadFile(name + archiveExtRLP)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("block not found in archive. number: %d", number)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var block types.Block
+	if err := rlp.DecodeBytes(data, &block); err != nil {
+		return nil, fmt.Errorf("decode block %d failed: %w", number, err)
+	}
+
+	return &block, nil
+}
+
+func decodeArchivedJSON(data []byte) (*types.Block, error) {
+	var response struct {
+		Result json.RawMessage `json:"result"`
+	}
+
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	if len(response.Result) != 0 {
+		data = response.Result
+	}
+
+	return decodeBlock(data)
+}
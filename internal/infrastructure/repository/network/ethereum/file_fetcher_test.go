@@ -0,0 +1,84 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func newArchivedHeader(number uint64, parent common.Hash) *types.Header {
+	return &types.Header{
+		ParentHash: parent,
+		UncleHash:  types.EmptyUncleHash,
+		TxHash:     types.EmptyTxsHash,
+		Difficulty: common.Big0,
+		Number:     new(big.Int).SetUint64(number),
+	}
+}
+
+func TestFileFetcher(t *testing.T) {
+	dir := t.TempDir()
+
+	// 100: raw result, 101: json-rpc response, 102: rlp
+	h100 := newArchivedHeader(100, common.Hash{})
+	h101 := newArchivedHeader(101, h100.Hash())
+	h102 := newArchivedHeader(102, h101.Hash())
+
+	data, _ := json.Marshal(h100)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "100.json"), data, 0o644))
+
+	data, _ = json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "result": h101})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "101.json"), data, 0o644))
+
+	data, _ = rlp.EncodeToBytes(types.NewBlockWithHeader(h102))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "102.rlp"), data, 0o644))
+
+	fetcher, err := NewFileFetcher(dir, nil, log.DefaultLogger)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	latest, err := fetcher.GetBlockHeaderByNumber(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(102), latest.Number)
+	assert.Equal(t, h102.Hash().String(), latest.Hash)
+
+	blocks, err := fetcher.GetBlocksByRange(ctx, 100, 3)
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 3)
+	for i := 1; i < len(blocks); i++ {
+		assert.Equal(t, blocks[i-1].Hash, blocks[i].ParentHash)
+	}
+
+	_, err = fetcher.GetBlockByNumber(ctx, 103)
+	assert.Error(t, err)
+
+	// the highest block is cached until the directory is modified.
+	info, err := os.Stat(dir)
+	assert.NoError(t, err)
+
+	h103 := newArchivedHeader(103, h102.Hash())
+	data, _ = json.Marshal(h103)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "103.json"), data, 0o644))
+	assert.NoError(t, os.Chtimes(dir, info.ModTime(), info.ModTime()))
+
+	number, err := fetcher.GetBlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(102), number)
+
+	modTime := info.ModTime().Add(time.Second)
+	assert.NoError(t, os.Chtimes(dir, modTime, modTime))
+
+	number, err = fetcher.GetBlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(103), number)
+}
@@ -0,0 +1,104 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// limitExceededCode is returned by infura/alchemy style providers when the request rate is exceeded.
+const limitExceededCode = -32005
+
+func isRateLimited(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == limitExceededCode {
+		return true
+	}
+
+	return false
+}
+
+// adaptiveLimiter bounds the number of in-flight requests. the limit grows by one while latency stays
+// close to the average, shrinks by one when latency spikes and halves when the provider rate-limits.
+type adaptiveLimiter struct {
+	mutex    sync.Mutex
+	limit    int
+	min      int
+	max      int
+	inflight int
+	latency  time.Duration
+	released chan struct{}
+}
+
+func newAdaptiveLimiter(minLimit, maxLimit int) *adaptiveLimiter {
+	minLimit = max(minLimit, 1)
+	maxLimit = max(maxLimit, minLimit)
+	return &adaptiveLimiter{
+		limit:    minLimit,
+		min:      minLimit,
+		max:      maxLimit,
+		released: make(chan struct{}),
+	}
+}
+
+func (l *adaptiveLimiter) Acquire(ctx context.Context) error {
+	for {
+		l.mutex.Lock()
+		if l.inflight < l.limit {
+			l.inflight++
+			l.mutex.Unlock()
+			return nil
+		}
+		released := l.released
+		l.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// Release returns the slot and adapts the limit to the result of the request.
+func (l *adaptiveLimiter) Release(latency time.Duration, err error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.inflight--
+	close(l.released)
+	l.released = make(chan struct{})
+
+	switch {
+	case err != nil && isRateLimited(err):
+		l.limit = max(l.limit/2, l.min)
+
+	case err != nil:
+
+	case l.latency == 0:
+		l.latency = latency
+
+	default:
+		if latency > l.latency*2 {
+			l.limit = max(l.limit-1, l.min)
+		} else {
+			l.limit = min(l.limit+1, l.max)
+		}
+		l.latency = (l.latency*4 + latency) / 5
+	}
+}
+
+func (l *adaptiveLimiter) Limit() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.limit
+}
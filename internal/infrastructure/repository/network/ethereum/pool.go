@@ -0,0 +1,159 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultQuorum      = 1
+	defaultMaxFailures = 3
+	defaultCooldown    = 30 * time.Second
+
+	maxScore       = 100
+	successBonus   = 1
+	failurePenalty = 10
+)
+
+var errNoEndpoint = errors.New("no ethereum rpc endpoint available")
+
+type endpoint struct {
+	idx int
+	cli *ethclient.Client
+
+	score         int64
+	failures      int64
+	disabledUntil time.Time
+}
+
+// clientPool keeps a health score per endpoint. endpoints are taken out of rotation
+// after maxFailures consecutive failures and come back after cooldown.
+type clientPool struct {
+	mutex       sync.Mutex
+	endpoints   []*endpoint
+	quorum      int
+	maxFailures int64
+	cooldown    time.Duration
+	logger      *log.Helper
+}
+
+func newClientPool(endpoints []*endpoint, quorum int, maxFailures int64, cooldown time.Duration, logger *log.Helper) *clientPool {
+	if quorum <= 0 {
+		quorum = defaultQuorum
+	}
+	if quorum > len(endpoints) {
+		quorum = len(endpoints)
+	}
+	if maxFailures <= 0 {
+		maxFailures = defaultMaxFailures
+	}
+	if cooldown <= 0 {
+		cooldown = defaultCooldown
+	}
+
+	for idx, ep := range endpoints {
+		ep.idx = idx
+		ep.score = maxScore
+	}
+
+	return &clientPool{
+		endpoints:   endpoints,
+		quorum:      quorum,
+		maxFailures: maxFailures,
+		cooldown:    cooldown,
+		logger:      logger,
+	}
+}
+
+// available returns the endpoints in rotation ordered by score.
+// if fewer than min endpoints are in rotation, the others are appended so the indexer keeps trying.
+func (p *clientPool) available(min int) []*endpoint {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var (
+		now      = time.Now()
+		list     = make([]*endpoint, 0, len(p.endpoints))
+		disabled = make([]*endpoint, 0)
+	)
+	for _, ep := range p.endpoints {
+		if now.Before(ep.disabledUntil) {
+			disabled = append(disabled, ep)
+			continue
+		}
+		list = append(list, ep)
+	}
+
+	byScore := func(eps []*endpoint) {
+		sort.SliceStable(eps, func(i, j int) bool {
+			return eps[i].score > eps[j].score
+		})
+	}
+	byScore(list)
+
+	if len(list) < min {
+		byScore(disabled)
+		list = append(list, disabled...)
+	}
+
+	return list
+}
+
+func (p *clientPool) markSuccess(ep *endpoint) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	ep.failures = 0
+	ep.score += successBonus
+	if ep.score > maxScore {
+		ep.score = maxScore
+	}
+}
+
+func (p *clientPool) markFailure(ep *endpoint, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	ep.failures++
+	ep.score -= failurePenalty
+	if ep.score < 0 {
+		ep.score = 0
+	}
+
+	if ep.failures >= p.maxFailures {
+		ep.failures = 0
+		ep.disabledUntil = time.Now().Add(p.cooldown)
+		p.logger.Warnf("endpoint out of rotation. client_idx: %d, cooldown: %s, error: %v", ep.idx, p.cooldown, err)
+	}
+}
+
+// call runs fn against the available endpoints until one succeeds.
+func call[T any](ctx context.Context, p *clientPool, fn func(cli *ethclient.Client) (T, error)) (T, error) {
+	var (
+		zero    T
+		lastErr = errNoEndpoint
+	)
+
+	for _, ep := range p.available(1) {
+		result, err := fn(ep.cli)
+		if err == nil {
+			p.markSuccess(ep)
+			return result, nil
+		}
+
+		if ctx.Err() != nil {
+			return zero, ctx.Err()
+		}
+
+		p.markFailure(ep, err)
+		lastErr = err
+	}
+
+	return zero, lastErr
+}
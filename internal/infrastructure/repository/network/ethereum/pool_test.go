@@ -0,0 +1,53 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPool(n int, quorum int) *clientPool {
+	var endpoints []*endpoint
+	for i := 0; i < n; i++ {
+		endpoints = append(endpoints, &endpoint{})
+	}
+	return newClientPool(endpoints, quorum, 2, time.Minute, log.NewHelper(log.DefaultLogger))
+}
+
+func TestClientPoolRotation(t *testing.T) {
+	pool := newTestPool(3, 5)
+	assert.Equal(t, 3, pool.quorum)
+
+	bad := pool.endpoints[0]
+	pool.markFailure(bad, errors.New("timeout"))
+	assert.Equal(t, 0, pool.available(1)[2].idx)
+
+	pool.markFailure(bad, errors.New("timeout"))
+	assert.Len(t, pool.available(1), 2)
+	assert.Len(t, pool.available(3), 3)
+
+	bad.disabledUntil = time.Now()
+	pool.markSuccess(bad)
+	assert.Len(t, pool.available(1), 3)
+}
+
+func TestClientPoolCallFailover(t *testing.T) {
+	pool := newTestPool(3, 1)
+
+	var calls int
+	result, err := call(context.Background(), pool, func(_ *ethclient.Client) (int, error) {
+		calls++
+		if calls < 3 {
+			return 0, errors.New("unavailable")
+		}
+		return 42, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, result)
+	assert.Equal(t, 2, pool.available(1)[0].idx)
+}
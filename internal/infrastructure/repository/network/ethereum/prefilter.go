@@ -0,0 +1,85 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const PrefilterLocal = "local"
+
+type FilteredTransaction struct {
+	// Position is the index of the transaction in the block.
+	Position int
+	Tx       *types.Transaction
+}
+
+// FilteredBlock is a block header with the transactions matched by a Prefilter only.
+type FilteredBlock struct {
+	Header       *types.Header
+	Transactions []*FilteredTransaction
+}
+
+// Prefilter returns blocks [startAt, startAt+size) carrying only the transactions whose calldata
+// starts with the protocol header, so that full blocks need not be downloaded and decoded by the fetcher.
+// an indexing node near the chain data is expected to serve it.
+type Prefilter interface {
+	FilterBlocks(ctx context.Context, startAt uint64, size uint64) ([]*FilteredBlock, error)
+}
+
+type rawBlockSource interface {
+	getRawBlocks(ctx context.Context, startAt uint64, size uint64) ([]*types.Block, error)
+}
+
+// LocalPrefilter is an in-process stand-in for an indexing node. it scans full blocks of a source,
+// usually a node next to the indexer, and keeps the matched transactions.
+type LocalPrefilter struct {
+	source rawBlockSource
+	prefix []byte
+}
+
+func newLocalPrefilter(source rawBlockSource, prefix string) *LocalPrefilter {
+	return &LocalPrefilter{source: source, prefix: []byte(prefix)}
+}
+
+func (p *LocalPrefilter) FilterBlocks(ctx context.Context, startAt uint64, size uint64) ([]*FilteredBlock, error) {
+	blocks, err := p.source.getRawBlocks(ctx, startAt, size)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*FilteredBlock, 0, len(blocks))
+	for _, block := range blocks {
+		filtered = append(filtered, filterBlock(block, p.prefix))
+	}
+
+	return filtered, nil
+}
+
+func filterBlock(block *types.Block, prefix []byte) *FilteredBlock {
+	filtered := &FilteredBlock{Header: block.Header()}
+	for position, tx := range block.Transactions() {
+		if !bytes.HasPrefix(tx.Data(), prefix) {
+			continue
+		}
+		filtered.Transactions = append(filtered.Transactions, &FilteredTransaction{Position: position, Tx: tx})
+	}
+
+	return filtered
+}
+
+func checkFilteredBlocks(blocks []*FilteredBlock, startAt uint64, size uint64) error {
+	if uint64(len(blocks)) != size {
+		return fmt.Errorf("prefilter returned %d blocks, want %d", len(blocks), size)
+	}
+
+	for i, block := range blocks {
+		if block == nil || block.Header == nil || block.Header.Number.Uint64() != startAt+uint64(i) {
+			return fmt.Errorf("prefilter returned unexpected block at %d", startAt+uint64(i))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type rawBlocks []*types.Block
+
+func (r rawBlocks) getRawBlocks(_ context.Context, startAt uint64, size uint64) ([]*types.Block, error) {
+	return r[startAt : startAt+size], nil
+}
+
+func TestLocalPrefilter(t *testing.T) {
+	newTx := func(data string) *types.Transaction {
+		return types.NewTx(&types.LegacyTx{Data: []byte(data)})
+	}
+
+	var source rawBlocks
+	for i := 0; i < 2; i++ {
+		header := newArchivedHeader(uint64(i), common.Hash{})
+		source = append(source, types.NewBlockWithHeader(header).WithBody([]*types.Transaction{
+			newTx("0xa9059cbb"),
+			newTx(protocol.ProtocolHeader + `{"p":"ierc-20","op":"mint"}`),
+			newTx(""),
+			newTx(protocol.ProtocolHeader + `{"p":"ierc-20","op":"transfer"}`),
+		}, nil))
+	}
+
+	prefilter := newLocalPrefilter(source, protocol.ProtocolHeader)
+	blocks, err := prefilter.FilterBlocks(context.Background(), 0, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, checkFilteredBlocks(blocks, 0, 2))
+
+	for _, block := range blocks {
+		assert.Len(t, block.Transactions, 2)
+		assert.Equal(t, 1, block.Transactions[0].Position)
+		assert.Equal(t, 3, block.Transactions[1].Position)
+	}
+
+	assert.Error(t, checkFilteredBlocks(blocks, 1, 2))
+}
+
+func TestPrefilterWithQuorum(t *testing.T) {
+	config := &conf.Config{Bootstrap: &conf.Bootstrap{Data: &conf.Data{Ethereum: &conf.Data_Ethereum{
+		Endpoints: []string{"http://127.0.0.1:8545", "http://127.0.0.1:8546"},
+		Quorum:    2,
+		Prefilter: PrefilterLocal,
+	}}}}
+
+	_, err := NewEthereumFetcher(config, nil, log.DefaultLogger)
+	assert.ErrorContains(t, err, "quorum")
+}
@@ -0,0 +1,71 @@
+package ethereum
+
+import (
+	"context"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var resubscribeInterval = 5 * time.Second
+
+// SubscribeNewHead subscribes newHeads on the websocket endpoint, the endpoint is dialed again and
+// resubscribed on error, including the first dial. heads are only a wake-up signal, they are dropped
+// while the receiver is busy.
+func (e *EthereumFetcher) SubscribeNewHead(ctx context.Context) (<-chan *domain.BlockHeader, error) {
+	if e.wsEndpoint == "" {
+		return nil, nil
+	}
+
+	out := make(chan *domain.BlockHeader, 1)
+	go func() {
+		defer close(out)
+
+		for {
+			if err := e.subscribeNewHead(ctx, out); err != nil && ctx.Err() == nil {
+				e.logger.Warnf("newHeads subscription failed, resubscribe after %s. err: %s", resubscribeInterval, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(resubscribeInterval):
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (e *EthereumFetcher) subscribeNewHead(ctx context.Context, out chan<- *domain.BlockHeader) error {
+	cli, err := ethclient.DialContext(ctx, e.wsEndpoint)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	heads := make(chan *types.Header, 16)
+	sub, err := cli.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case err := <-sub.Err():
+			return err
+
+		case head := <-heads:
+			select {
+			case out <- convertHeader(head):
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeHeadService notifies a head numbered by the count of subscriptions on every subscription.
+type fakeHeadService struct {
+	subscriptions atomic.Int64
+}
+
+func (s *fakeHeadService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+
+	number := s.subscriptions.Add(1)
+	go func() {
+		_ = notifier.Notify(sub.ID, &types.Header{Number: big.NewInt(number), Difficulty: big.NewInt(0)})
+	}()
+
+	return sub, nil
+}
+
+// connListener keeps the accepted connections to drop them, the websocket connections are hijacked
+// from the http server.
+type connListener struct {
+	net.Listener
+	conns chan net.Conn
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.conns <- conn
+	}
+	return conn, err
+}
+
+func TestSubscribeNewHead(t *testing.T) {
+	defer func(interval time.Duration) { resubscribeInterval = interval }(resubscribeInterval)
+	resubscribeInterval = time.Millisecond * 50
+
+	// the endpoint is down on the first dial.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	addr := listener.Addr().String()
+	assert.NoError(t, listener.Close())
+
+	fetcher := &EthereumFetcher{wsEndpoint: "ws://" + addr, logger: log.NewHelper(log.DefaultLogger)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	heads, err := fetcher.SubscribeNewHead(ctx)
+	assert.NoError(t, err)
+	time.Sleep(resubscribeInterval * 2)
+
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("eth", new(fakeHeadService)))
+	defer server.Stop()
+
+	srv := httptest.NewUnstartedServer(server.WebsocketHandler([]string{"*"}))
+	listener, err = net.Listen("tcp", addr)
+	assert.NoError(t, err)
+	conns := make(chan net.Conn, 16)
+	srv.Listener = &connListener{Listener: listener, conns: conns}
+	srv.Start()
+	defer srv.Close()
+
+	receive := func() uint64 {
+		select {
+		case head := <-heads:
+			return head.Number
+		case <-time.After(time.Second * 5):
+			t.Fatal("no head received")
+			return 0
+		}
+	}
+
+	assert.Equal(t, uint64(1), receive())
+
+	// the connection is dropped, the endpoint is dialed and subscribed again.
+	assert.NoError(t, (<-conns).Close())
+	assert.Equal(t, uint64(2), receive())
+
+	cancel()
+	for range heads {
+	}
+}
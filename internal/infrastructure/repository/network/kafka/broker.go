@@ -0,0 +1,207 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
+	"github.com/go-kratos/kratos/v2/log"
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+const (
+	headerID          = "outbox-id"
+	headerBlockNumber = "block-number"
+
+	readTimeout     = 10 * time.Second
+	maxMessageBytes = 10 << 20
+)
+
+// Broker writes the messages to a kafka topic, partitioned by key so that the messages of a tick are ordered.
+//
+// kafka-go has no idempotent producer, so the broker keeps the id of the last message stored in every partition
+// and skips the messages already stored. the ids are read back from the topic on start and after a failed
+// write, a batch published again by the relay is then stored exactly once. it is not safe for concurrent use.
+type Broker struct {
+	addrs  []string
+	topic  string
+	writer *kafkago.Writer
+	logger *log.Helper
+
+	// partitions of the topic and the id of the last message stored in each, nil when unknown.
+	partitions []int
+	lastIDs    map[int]int64
+}
+
+func NewBroker(addrs []string, topic string, logger log.Logger) (*Broker, error) {
+	if len(addrs) == 0 || topic == "" {
+		return nil, errors.New("kafka addrs and topic are required")
+	}
+
+	return &Broker{
+		addrs: addrs,
+		topic: topic,
+		writer: &kafkago.Writer{
+			Addr:         kafkago.TCP(addrs...),
+			Topic:        topic,
+			Balancer:     &kafkago.Hash{},
+			RequiredAcks: kafkago.RequireAll,
+			// retries may store a batch twice, they are left to the relay.
+			MaxAttempts:  1,
+			BatchSize:    1000,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+		logger: log.NewHelper(log.With(logger, "module", "kafka/broker")),
+	}, nil
+}
+
+func (b *Broker) Publish(ctx context.Context, messages []*outbox.Message) error {
+	if b.lastIDs == nil {
+		if err := b.loadLastIDs(ctx); err != nil {
+			return err
+		}
+	}
+
+	var (
+		msgs   = make([]kafkago.Message, 0, len(messages))
+		stored = make(map[int]int64)
+	)
+
+	for _, message := range messages {
+		msg := kafkago.Message{
+			// an empty key must not be nil, nil keys are balanced round robin.
+			Key:   append([]byte{}, message.Key...),
+			Value: message.Payload,
+			Headers: []kafkago.Header{
+				{Key: headerID, Value: []byte(strconv.FormatInt(message.ID, 10))},
+				{Key: headerBlockNumber, Value: []byte(strconv.FormatUint(message.BlockNumber, 10))},
+			},
+		}
+
+		partition := b.partitionOf(msg)
+		if message.ID <= b.lastIDs[partition] {
+			continue
+		}
+
+		msgs = append(msgs, msg)
+		stored[partition] = message.ID
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := b.writer.WriteMessages(ctx, msgs...); err != nil {
+		// part of the messages may have been stored.
+		b.lastIDs = nil
+		return err
+	}
+
+	for partition, id := range stored {
+		b.lastIDs[partition] = id
+	}
+
+	return nil
+}
+
+// partitionOf returns the partition chosen by the writer.
+func (b *Broker) partitionOf(msg kafkago.Message) int {
+	return (&kafkago.Hash{}).Balance(msg, b.partitions...)
+}
+
+func (b *Broker) loadLastIDs(ctx context.Context) error {
+	partitions, err := b.readPartitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(partitions) == 0 {
+		return fmt.Errorf("topic %s has no partitions", b.topic)
+	}
+
+	var (
+		ids = make([]int, 0, len(partitions))
+		m   = make(map[int]int64, len(partitions))
+	)
+
+	for _, partition := range partitions {
+		lastID, err := b.readLastID(ctx, partition)
+		if err != nil {
+			return err
+		}
+
+		ids = append(ids, partition.ID)
+		m[partition.ID] = lastID
+	}
+
+	b.logger.Infof("load last message ids. topic: %s, ids: %v", b.topic, m)
+	b.partitions, b.lastIDs = ids, m
+	return nil
+}
+
+func (b *Broker) readPartitions(ctx context.Context) ([]kafkago.Partition, error) {
+	var lastErr error
+	for _, addr := range b.addrs {
+		conn, err := kafkago.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		partitions, err := conn.ReadPartitions(b.topic)
+		_ = conn.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		return partitions, nil
+	}
+
+	return nil, fmt.Errorf("read partitions of %s: %w", b.topic, lastErr)
+}
+
+// readLastID returns the id of the last message of the partition, 0 if it is empty.
+func (b *Broker) readLastID(ctx context.Context, partition kafkago.Partition) (int64, error) {
+	leader := net.JoinHostPort(partition.Leader.Host, strconv.Itoa(partition.Leader.Port))
+	conn, err := kafkago.DialLeader(ctx, "tcp", leader, b.topic, partition.ID)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	first, last, err := conn.ReadOffsets()
+	if err != nil {
+		return 0, err
+	}
+
+	if last <= first {
+		return 0, nil
+	}
+
+	if _, err := conn.Seek(last-1, kafkago.SeekAbsolute); err != nil {
+		return 0, err
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	msg, err := conn.ReadMessage(maxMessageBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, header := range msg.Headers {
+		if header.Key == headerID {
+			return strconv.ParseInt(string(header.Value), 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("message without %s header. partition: %d, offset: %d", headerID, partition.ID, msg.Offset)
+}
+
+func (b *Broker) Close() error {
+	return b.writer.Close()
+}
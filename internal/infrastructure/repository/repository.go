@@ -1,39 +1,64 @@
 package repository
 
 import (
+	"fmt"
+
+	"github.com/IErcOrg/IERC_Indexer/internal/conf"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/balance"
+	"github.com/IErcOrg/IERC_Indexer/internal/domain/outbox"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/protocol/parser"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/staking"
 	"github.com/IErcOrg/IERC_Indexer/internal/domain/tick"
 	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/memory"
 	mysqlimpl "github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/mysql"
 	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/network/ethereum"
+	"github.com/IErcOrg/IERC_Indexer/internal/infrastructure/repository/network/kafka"
 	"github.com/allegro/bigcache"
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 	"gorm.io/gorm"
 )
 
 var ProviderSet = wire.NewSet(
 	NewDB,
+	NewMigrator,
 	NewCache,
 	NewData,
 	NewTransactionRepository,
 	NewProtocolParser,
-	NewEthereumFetcher,
+	NewBlockFetcher,
 	NewBlockRepository,
 	NewTickRepository,
 	NewBalanceRepository,
 	NewEventRepository,
 	NewStakingRepository,
+	NewJournalRepository,
+	NewWebhookRepository,
+	NewOutboxRepository,
+	NewBroker,
+	NewListingRepository,
+	NewStatsRepository,
 )
 
 var (
-	NewProtocolParser  = parser.NewParser
-	NewEthereumFetcher = ethereum.NewEthereumFetcher
-	NewBlockRepository = mysqlimpl.NewBlockRepo
-	NewEventRepository = mysqlimpl.NewEventRepository
+	NewProtocolParser    = parser.NewParser
+	NewBlockRepository   = mysqlimpl.NewBlockRepo
+	NewEventRepository   = mysqlimpl.NewEventRepository
+	NewJournalRepository = mysqlimpl.NewJournalRepository
+	NewWebhookRepository = mysqlimpl.NewWebhookRepository
+	NewListingRepository = mysqlimpl.NewListingRepository
+	NewStatsRepository   = mysqlimpl.NewStatsRepository
 )
 
+func NewBlockFetcher(conf *conf.Config, parser parser.Parser, logger log.Logger) (domain.BlockFetcher, error) {
+	if dir := conf.Bootstrap.Data.Ethereum.GetArchiveDir(); dir != "" {
+		return ethereum.NewFileFetcher(dir, parser, logger)
+	}
+
+	return ethereum.NewEthereumFetcher(conf, parser, logger)
+}
+
 func NewTickRepository(db *gorm.DB, cache *bigcache.BigCache) tick.TickRepository {
 	return memory.NewTickMemoryRepository(mysqlimpl.NewTickRepo(db), cache)
 }
@@ -45,3 +70,30 @@ func NewBalanceRepository(db *gorm.DB, cache *bigcache.BigCache) balance.Balance
 func NewStakingRepository(db *gorm.DB) (staking.StakingRepository, error) {
 	return memory.NewStakingMemoryRepository(mysqlimpl.NewStakingRepository(db))
 }
+
+func NewOutboxRepository(conf *conf.Config, db *gorm.DB) outbox.Repository {
+	return mysqlimpl.NewOutboxRepository(db, conf.Bootstrap.Data.GetBroker().GetDriver() != "")
+}
+
+// NewBroker returns the broker of the outbox, nil when the outbox is disabled.
+func NewBroker(conf *conf.Config, logger log.Logger) (outbox.Broker, func(), error) {
+	c := conf.Bootstrap.Data.GetBroker()
+	switch c.GetDriver() {
+	case "":
+		return nil, func() {}, nil
+
+	case "memory":
+		return memory.NewBroker(), func() {}, nil
+
+	case "kafka":
+		broker, err := kafka.NewBroker(c.GetAddrs(), c.GetTopic(), logger)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return broker, func() { _ = broker.Close() }, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown broker driver: %s", c.GetDriver())
+	}
+}
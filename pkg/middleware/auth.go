@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// AdminAuth requires the bearer token in the Authorization header. every request is rejected
+// when the token is empty.
+func AdminAuth(token string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+
+			if token == "" {
+				return nil, errors.Forbidden("ADMIN_DISABLED", "admin rpcs are disabled")
+			}
+
+			ts, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.Unauthorized("UNAUTHORIZED", "missing admin token")
+			}
+
+			given, found := strings.CutPrefix(ts.RequestHeader().Get("Authorization"), "Bearer ")
+			if !found || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				return nil, errors.Unauthorized("UNAUTHORIZED", "invalid admin token")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
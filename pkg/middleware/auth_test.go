@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+type headerTransport struct {
+	transport.Transporter
+	header nethttp.Header
+}
+
+func (t *headerTransport) RequestHeader() transport.Header {
+	return headerCarrier(t.header)
+}
+
+type headerCarrier nethttp.Header
+
+func (c headerCarrier) Get(key string) string      { return nethttp.Header(c).Get(key) }
+func (c headerCarrier) Set(key, value string)      { nethttp.Header(c).Set(key, value) }
+func (c headerCarrier) Add(key, value string)      { nethttp.Header(c).Add(key, value) }
+func (c headerCarrier) Keys() []string             { return nil }
+func (c headerCarrier) Values(key string) []string { return nethttp.Header(c).Values(key) }
+
+func TestAdminAuth(t *testing.T) {
+	next := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(token, authorization string) error {
+		header := nethttp.Header{}
+		if authorization != "" {
+			header.Set("Authorization", authorization)
+		}
+
+		ctx := transport.NewServerContext(context.Background(), &headerTransport{header: header})
+		_, err := AdminAuth(token)(next)(ctx, nil)
+		return err
+	}
+
+	assert.NoError(t, call("secret", "Bearer secret"))
+	assert.True(t, errors.IsUnauthorized(call("secret", "Bearer wrong")))
+	assert.True(t, errors.IsUnauthorized(call("secret", "")))
+	assert.True(t, errors.IsForbidden(call("", "Bearer ")))
+}
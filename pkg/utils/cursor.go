@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor packs the sort key of the last item of a page into an opaque page cursor.
+func EncodeCursor(values ...string) string {
+	bytes, _ := json.Marshal(values)
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+// DecodeCursor unpacks a cursor made by EncodeCursor, which must carry n values.
+func DecodeCursor(cursor string, n int) ([]string, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var values []string
+	if err = json.Unmarshal(bytes, &values); err != nil || len(values) != n {
+		return nil, ErrInvalidCursor
+	}
+
+	return values, nil
+}